@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+	"github.com/mst-mkt/cf-open/internal/config"
+	"github.com/mst-mkt/cf-open/internal/output"
+)
+
+// completeQuery は位置引数のクエリとしてローカルの設定にあるリソースの種類・バインディング名を補完する
+func completeQuery(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	wranglerConfig, ok := loadCompletionConfig()
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// 環境を適用できない場合は最上位の設定のリソースを候補にする
+	if applied, err := config.ApplyEnv(wranglerConfig, resolveEnvName(opts)); err == nil {
+		wranglerConfig = applied
+	}
+
+	resources := cloudflare.GetResourcesFromConfig(wranglerConfig, "", false)
+
+	var completions []cobra.Completion
+	var seen []string
+	add := func(value, description string) {
+		if value == "" || slices.Contains(seen, value) || !hasPrefixFold(value, toComplete) {
+			return
+		}
+		// サブコマンドと同じ名前はクエリとして解釈されないため候補にしない
+		if sub, _, err := cmd.Find([]string{value}); err == nil && sub != cmd {
+			return
+		}
+		seen = append(seen, value)
+		completions = append(completions, cobra.CompletionWithDesc(value, description))
+	}
+
+	for _, r := range resources {
+		add(string(r.Type), fmt.Sprintf("all %s resources", r.Type))
+	}
+	for _, r := range resources {
+		add(r.Name, r.Display())
+	}
+	for _, r := range resources {
+		if r.Name != "" {
+			add(fmt.Sprintf("%s:%s", r.Type, r.Name), r.Display())
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeEnv は `--env` の値としてローカルの設定にある環境名を補完する
+func completeEnv(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	wranglerConfig, ok := loadCompletionConfig()
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, name := range config.EnvNames(wranglerConfig) {
+		if hasPrefixFold(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeResourceType は `--type` / `--exclude-type` の値としてリソースの種類を補完する
+//
+// `kv,d1` のようにカンマで区切った値の最後の要素を補完する
+func completeResourceType(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	prefix, last := "", toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix, last = toComplete[:i+1], toComplete[i+1:]
+	}
+
+	var completions []cobra.Completion
+	for _, t := range cloudflare.AllResourceTypes {
+		if hasPrefixFold(string(t), last) {
+			completions = append(completions, prefix+string(t))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// loadCompletionConfig は補完のために設定を読み込む
+//
+// 補完の出力を乱さないよう、読み込めない場合やリダイレクトされた場合もメッセージを出さない
+func loadCompletionConfig() (*config.WranglerConfig, bool) {
+	wranglerConfig, err := config.LoadWranglerConfig(opts.wranglerConfig)
+	if err != nil {
+		return nil, false
+	}
+	return wranglerConfig, true
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func formatNames() []cobra.Completion {
+	names := make([]cobra.Completion, len(output.AllFormats))
+	for i, f := range output.AllFormats {
+		names[i] = string(f)
+	}
+	return names
+}
+
+// registerCompletions は位置引数とフラグの値の補完を登録する。フラグを定義した後に呼び出す
+func registerCompletions() {
+	rootCmd.ValidArgsFunction = completeQuery
+
+	cobra.CheckErr(rootCmd.RegisterFlagCompletionFunc("env", completeEnv))
+	cobra.CheckErr(rootCmd.RegisterFlagCompletionFunc("type", completeResourceType))
+	cobra.CheckErr(rootCmd.RegisterFlagCompletionFunc("exclude-type", completeResourceType))
+	cobra.CheckErr(rootCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions(formatNames(), cobra.ShellCompDirectiveNoFileComp)))
+}
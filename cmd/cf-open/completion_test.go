@@ -0,0 +1,198 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+)
+
+// completionValues は補完候補から説明を除いた値を返す
+func completionValues(completions []cobra.Completion) []string {
+	values := make([]string, len(completions))
+	for i, c := range completions {
+		values[i], _, _ = strings.Cut(c, "\t")
+	}
+	return values
+}
+
+func TestCompleteQuery(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "wrangler.toml")
+	content := `
+name = "my-worker"
+
+[[kv_namespaces]]
+binding = "CACHE"
+id = "kv-cache"
+
+[[kv_namespaces]]
+binding = "SESSION"
+id = "kv-session"
+
+[[d1_databases]]
+binding = "DB"
+database_name = "db"
+database_id = "d1-id"
+
+[[queues.consumers]]
+queue = "jobs"
+
+[[r2_buckets]]
+binding = "list"
+bucket_name = "list"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0o644); err != nil {
+		t.Fatalf("ファイルの書き込みに失敗: %v", err)
+	}
+
+	// completeQuery はグローバルな opts と CLOUDFLARE_ENV から設定を読み込むため並列に実行しない
+	saved := opts
+	t.Cleanup(func() { opts = saved })
+	opts = options{wranglerConfig: configPath}
+	t.Setenv("CLOUDFLARE_ENV", "")
+
+	tests := []struct {
+		name       string
+		args       []string
+		toComplete string
+		want       []string
+	}{
+		{
+			name:       "種類・バインディング名・種類:名前の順に重複なく候補を返す",
+			toComplete: "",
+			// バインディングのないキューは種類のみを候補にする
+			want: []string{
+				"worker", "queue", "r2", "kv", "d1",
+				"my-worker", "CACHE", "SESSION", "DB",
+				"worker:my-worker", "r2:list", "kv:CACHE", "kv:SESSION", "d1:DB",
+			},
+		},
+		{
+			name:       "サブコマンドと同じ名前は候補にしない",
+			toComplete: "li",
+			want:       []string{},
+		},
+		{
+			name:       "種類の前方一致",
+			toComplete: "k",
+			want:       []string{"kv", "kv:CACHE", "kv:SESSION"},
+		},
+		{
+			name:       "大文字小文字を区別しない",
+			toComplete: "se",
+			want:       []string{"SESSION"},
+		},
+		{
+			name:       "種類:名前の形式",
+			toComplete: "d1:",
+			want:       []string{"d1:DB"},
+		},
+		{
+			name:       "一致する候補がない",
+			toComplete: "zzz",
+			want:       []string{},
+		},
+		{
+			name:       "クエリを指定済みの場合は補完しない",
+			args:       []string{"kv"},
+			toComplete: "",
+			want:       []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, directive := completeQuery(rootCmd, tt.args, tt.toComplete)
+			if directive != cobra.ShellCompDirectiveNoFileComp {
+				t.Errorf("completeQuery() directive = %v, want %v", directive, cobra.ShellCompDirectiveNoFileComp)
+			}
+			if values := completionValues(got); !slices.Equal(values, tt.want) {
+				t.Errorf("completeQuery() = %v, want %v", values, tt.want)
+			}
+		})
+	}
+
+	got, _ := completeQuery(rootCmd, nil, "kv")
+	if want := "kv\tall kv resources"; len(got) == 0 || got[0] != want {
+		t.Errorf("completeQuery()[0] = %v, want %q", got, want)
+	}
+}
+
+func TestCompleteResourceType(t *testing.T) {
+	t.Parallel()
+
+	// カンマの直後はすべての種類を候補にする
+	afterComma := make([]string, len(cloudflare.AllResourceTypes))
+	for i, rt := range cloudflare.AllResourceTypes {
+		afterComma[i] = "kv,d1," + string(rt)
+	}
+
+	tests := []struct {
+		name       string
+		toComplete string
+		want       []string
+	}{
+		{
+			name:       "前方一致",
+			toComplete: "d",
+			want:       []string{"durable_object", "d1", "dispatch_namespace"},
+		},
+		{
+			name:       "カンマ区切りの最後の要素を補完する",
+			toComplete: "kv,wor",
+			want:       []string{"kv,worker", "kv,workers_routes", "kv,workflow", "kv,workers_ai"},
+		},
+		{
+			name:       "カンマの直後",
+			toComplete: "kv,d1,",
+			want:       afterComma,
+		},
+		{
+			name:       "大文字小文字を区別しない",
+			toComplete: "KV",
+			want:       []string{"kv"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, directive := completeResourceType(rootCmd, nil, tt.toComplete)
+			if directive != cobra.ShellCompDirectiveNoFileComp {
+				t.Errorf("completeResourceType() directive = %v, want %v", directive, cobra.ShellCompDirectiveNoFileComp)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("completeResourceType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasPrefixFold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s      string
+		prefix string
+		want   bool
+	}{
+		{s: "CACHE", prefix: "", want: true},
+		{s: "CACHE", prefix: "ca", want: true},
+		{s: "kv:CACHE", prefix: "KV:c", want: true},
+		{s: "CACHE", prefix: "CACHE", want: true},
+		{s: "CACHE", prefix: "CACHES", want: false},
+		{s: "CACHE", prefix: "db", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := hasPrefixFold(tt.s, tt.prefix); got != tt.want {
+			t.Errorf("hasPrefixFold(%q, %q) = %v, want %v", tt.s, tt.prefix, got, tt.want)
+		}
+	}
+}
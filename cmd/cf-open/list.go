@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+	"github.com/mst-mkt/cf-open/internal/config"
+	"github.com/mst-mkt/cf-open/internal/output"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the resources in the wrangler config without opening the browser",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// list は選択もブラウザも使わないため、それらに関するフラグは受け付けない
+		for _, name := range []string{"all", "multi", "print"} {
+			if cmd.Flags().Changed(name) {
+				return fmt.Errorf("--%s cannot be used with list", name)
+			}
+		}
+		return runList(opts, os.Stdout)
+	},
+}
+
+func runList(opts options, w io.Writer) error {
+	wranglerConfig, err := loadWranglerConfig(opts)
+	if err != nil {
+		return err
+	}
+
+	wranglerConfig, err = applyEnv(wranglerConfig, resolveEnvName(opts))
+	if err != nil {
+		return err
+	}
+
+	accountID, accountSource := config.ResolveAccountID(wranglerConfig, opts.accountID)
+	hasAccount := accountSource != config.AccountSourceNone
+	resources := cloudflare.GetResourcesFromConfig(wranglerConfig, accountID, hasAccount)
+
+	// `--format` が指定された場合は表の代わりにその形式で書き出す
+	if format := resolveFormat(opts); format != "" {
+		parsed, err := output.ParseFormat(format)
+		if err != nil {
+			return err
+		}
+		return output.Write(w, resources, parsed, opts.template)
+	}
+
+	// Account ID がわからない場合は URL がダッシュボードでのアカウント選択を経由することを示す
+	header := "Account ID: not found (the dashboard will ask you to select an account)"
+	if hasAccount {
+		header = fmt.Sprintf("Account ID: %s (from %s)", accountID, accountSource)
+	}
+	if _, err := fmt.Fprintf(w, "%s\n\n", header); err != nil {
+		return err
+	}
+
+	if len(resources) == 0 {
+		_, err := fmt.Fprintln(w, "No resources found in wrangler config")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "TYPE\tBINDING\tID\tURL"); err != nil {
+		return err
+	}
+	for _, r := range resources {
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Type, r.Name, r.ID, r.URL); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+}
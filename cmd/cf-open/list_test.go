@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunList(t *testing.T) {
+	// runList は CLOUDFLARE_ENV から環境を解決するため並列に実行しない
+	t.Setenv("CLOUDFLARE_ENV", "")
+
+	configPath := filepath.Join(t.TempDir(), "wrangler.toml")
+	content := `
+name = "my-worker"
+
+[[kv_namespaces]]
+binding = "CACHE"
+id = "kv-id"
+
+[[d1_databases]]
+binding = "DB"
+database_name = "db"
+database_id = "d1-id"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0o644); err != nil {
+		t.Fatalf("ファイルの書き込みに失敗: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts options
+		want string
+	}{
+		{
+			name: "表形式",
+			opts: options{wranglerConfig: configPath, accountID: "acc"},
+			want: `Account ID: acc (from --account-id flag)
+
+TYPE    BINDING    ID         URL
+worker  my-worker  my-worker  https://dash.cloudflare.com/acc/workers/services/view/my-worker/production
+kv      CACHE      kv-id      https://dash.cloudflare.com/acc/workers/kv/namespaces/kv-id/metrics
+d1      DB         d1-id      https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics
+`,
+		},
+		{
+			name: "--format を指定した場合はその形式で書き出す",
+			opts: options{wranglerConfig: configPath, accountID: "acc", format: "csv"},
+			want: `type,binding,id,description,url
+worker,my-worker,my-worker,Worker: my-worker,https://dash.cloudflare.com/acc/workers/services/view/my-worker/production
+kv,CACHE,kv-id,KV: CACHE (kv-id),https://dash.cloudflare.com/acc/workers/kv/namespaces/kv-id/metrics
+d1,DB,d1-id,D1: db (d1-id),https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics
+`,
+		},
+		{
+			name: "--template のみを指定した場合はテンプレート形式",
+			opts: options{wranglerConfig: configPath, accountID: "acc", template: "{{.Type}} {{.Binding}}"},
+			want: "worker my-worker\nkv CACHE\nd1 DB\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := runList(tt.opts, &buf); err != nil {
+				t.Fatalf("runList() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("runList() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,12 +3,14 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/mst-mkt/cf-open/internal"
 	"github.com/mst-mkt/cf-open/internal/cloudflare"
 	"github.com/mst-mkt/cf-open/internal/config"
+	"github.com/mst-mkt/cf-open/internal/output"
 )
 
 var version = "dev"
@@ -16,58 +18,304 @@ var version = "dev"
 type options struct {
 	wranglerConfig string
 	accountID      string
+	env            string
 	all            bool
+	multi          bool
 	print          bool
+	verbose        bool
+	workspace      bool
+	preview        bool
+	types          []string
+	excludeTypes   []string
+	format         string
+	template       string
 }
 
 var opts options
 
 var rootCmd = &cobra.Command{
-	Use:     "cf-open",
+	Use:     "cf-open [query]",
 	Short:   "Open Cloudflare dashboard for your project from CLI",
+	Long:    "Open Cloudflare dashboard for your project from CLI.\n\nThe optional query matches a resource type, binding name or ID (e.g. `d1`, `DB`, `kv:CACHE`) and opens it without the prompt.",
 	Version: version,
+	Args:    cobra.MaximumNArgs(1),
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return validateOutputOptions(opts)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return run(opts)
+		var query string
+		if len(args) > 0 {
+			query = args[0]
+		}
+		return run(opts, query)
 	},
 }
 
-func run(opts options) error {
-	wranglerConfig, err := config.LoadWranglerConfig(opts.wranglerConfig)
+func run(opts options, query string) error {
+	resources, err := loadResources(opts)
 	if err != nil {
-		return fmt.Errorf("failed to load wrangler config: %w", err)
+		return err
 	}
 
-	accountID, hasAccount := config.GetAccountID(wranglerConfig, opts.accountID)
-
-	resources := cloudflare.GetResourcesFromConfig(wranglerConfig, accountID, hasAccount)
 	if len(resources) == 0 {
 		return fmt.Errorf("no resources found in wrangler config")
 	}
 
-	urls, err := selectURLs(resources, opts.all)
+	// `--type` / `--exclude-type` が指定された場合は種類で絞り込む
+	if len(opts.types) > 0 || len(opts.excludeTypes) > 0 {
+		include, err := parseResourceTypes(opts.types)
+		if err != nil {
+			return err
+		}
+		exclude, err := parseResourceTypes(opts.excludeTypes)
+		if err != nil {
+			return err
+		}
+
+		resources = cloudflare.FilterResources(resources, include, exclude)
+		if len(resources) == 0 {
+			return fmt.Errorf("no resources match the type filters")
+		}
+	}
+
+	// クエリが指定された場合は一致するリソースに絞り込む
+	if query != "" {
+		resources = cloudflare.MatchResources(resources, query)
+		if len(resources) == 0 {
+			return fmt.Errorf("no resources match %q", query)
+		}
+	}
+
+	selected, err := selectResources(resources, opts.all, opts.multi)
 	if err != nil {
 		return err
 	}
 
-	return outputURLs(urls, opts.print)
+	return outputResources(selected, opts)
+}
+
+func loadResources(opts options) ([]cloudflare.Resource, error) {
+	// `--workspace` が指定された場合はワークスペース内のすべてのプロジェクトを対象にする
+	if opts.workspace {
+		return loadWorkspaceResources(opts)
+	}
+
+	wranglerConfig, err := loadWranglerConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return getResources(wranglerConfig, resolveEnvName(opts), opts)
 }
 
-func selectURLs(resources []cloudflare.Resource, all bool) ([]string, error) {
-	// `--all` が指定された場合はすべてのリソースの URL を返す
+func loadWranglerConfig(opts options) (*config.WranglerConfig, error) {
+	wranglerConfig, err := config.LoadWranglerConfig(opts.wranglerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load wrangler config: %w", err)
+	}
+
+	// ビルドツールが生成した設定にリダイレクトされた場合はその旨を表示する
+	if wranglerConfig.IsRedirected() {
+		fmt.Fprintf(os.Stderr, "Using redirected config %s (from %s)\n", wranglerConfig.ConfigPath, wranglerConfig.DeployConfigPath)
+	}
+
+	return wranglerConfig, nil
+}
+
+func loadWorkspaceResources(opts options) ([]cloudflare.Resource, error) {
+	if opts.wranglerConfig != "" {
+		return nil, fmt.Errorf("--workspace cannot be used with --wrangler-config")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	root := config.FindWorkspaceRoot(cwd)
+	logVerbose(opts, "Searching wrangler configs in %s", root)
+
+	configPaths, err := config.FindWorkspaceConfigs(root)
+	if err != nil {
+		return nil, err
+	}
+	if len(configPaths) == 0 {
+		return nil, fmt.Errorf("no wrangler config found in workspace %s", root)
+	}
+
+	wranglerConfigs, err := config.LoadWranglerConfigs(configPaths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load wrangler config: %w", err)
+	}
+
+	envName := resolveEnvName(opts)
+
+	var resources []cloudflare.Resource
+	for i, wranglerConfig := range wranglerConfigs {
+		project := projectName(root, configPaths[i])
+
+		// ビルドツールが生成した設定にリダイレクトされた場合はその旨を表示する
+		if wranglerConfig.IsRedirected() {
+			fmt.Fprintf(os.Stderr, "%s: using redirected config %s (from %s)\n", project, wranglerConfig.ConfigPath, wranglerConfig.DeployConfigPath)
+		}
+
+		// 指定された環境を定義していないプロジェクトはスキップする
+		// 環境を 1 つも定義していないプロジェクトも、存在しない <name>-<env> を開かないようにスキップする
+		// Pages プロジェクトは環境がなければトップレベルの設定を使うのでスキップしない
+		if _, ok := wranglerConfig.Env[envName]; envName != "" && !ok && !wranglerConfig.IsPages() {
+			logVerbose(opts, "Skipping %s: environment %q not found", project, envName)
+			continue
+		}
+
+		projectResources, err := getResources(wranglerConfig, envName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", project, err)
+		}
+
+		for j := range projectResources {
+			projectResources[j].Project = project
+		}
+		resources = append(resources, projectResources...)
+	}
+
+	return resources, nil
+}
+
+func getResources(wranglerConfig *config.WranglerConfig, envName string, opts options) ([]cloudflare.Resource, error) {
+	wranglerConfig, err := applyEnv(wranglerConfig, envName)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, hasAccount := resolveAccountID(wranglerConfig, opts)
+
+	// `--preview` が指定された場合はプレビュー用のリソースのみを返す
+	if opts.preview {
+		return cloudflare.GetPreviewResourcesFromConfig(wranglerConfig, accountID, hasAccount), nil
+	}
+
+	return cloudflare.GetResourcesFromConfig(wranglerConfig, accountID, hasAccount), nil
+}
+
+// applyEnv は環境を適用する。環境が定義されていない設定では Wrangler と同様に警告を出す
+func applyEnv(wranglerConfig *config.WranglerConfig, envName string) (*config.WranglerConfig, error) {
+	resolved, err := config.ApplyEnv(wranglerConfig, envName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply environment: %w", err)
+	}
+
+	if config.IsUndefinedEnv(wranglerConfig, envName) {
+		fmt.Fprintf(os.Stderr, "Warning: no environment %q defined in %s, bindings of the top-level config are not inherited\n", envName, wranglerConfig.ConfigPath)
+	}
+	return resolved, nil
+}
+
+func resolveAccountID(wranglerConfig *config.WranglerConfig, opts options) (string, bool) {
+	accountID, accountSource := config.ResolveAccountID(wranglerConfig, opts.accountID)
+	hasAccount := accountSource != config.AccountSourceNone
+	if hasAccount {
+		logVerbose(opts, "Using account ID %s (from %s)", accountID, accountSource)
+	} else {
+		logVerbose(opts, "Account ID not found, falling back to account selection on the dashboard")
+	}
+	return accountID, hasAccount
+}
+
+func resolveEnvName(opts options) string {
+	envName, envSource := config.ResolveEnvName(opts.env)
+	if envSource != config.EnvSourceNone {
+		logVerbose(opts, "Using environment %q (from %s)", envName, envSource)
+	}
+	return envName
+}
+
+func parseResourceTypes(values []string) ([]cloudflare.ResourceType, error) {
+	types := make([]cloudflare.ResourceType, len(values))
+	for i, value := range values {
+		t, err := cloudflare.ParseResourceType(value)
+		if err != nil {
+			return nil, err
+		}
+		types[i] = t
+	}
+	return types, nil
+}
+
+// projectName はワークスペースのルートから見た設定ファイルのディレクトリをプロジェクト名とする
+func projectName(root, configPath string) string {
+	rel, err := filepath.Rel(root, filepath.Dir(configPath))
+	if err != nil || rel == "." {
+		return filepath.Base(root)
+	}
+	return filepath.ToSlash(rel)
+}
+
+func selectResources(resources []cloudflare.Resource, all, multi bool) ([]cloudflare.Resource, error) {
+	// `--all` が指定された場合はすべてのリソースを返す
 	if all {
-		urls := make([]string, len(resources))
-		for i, r := range resources {
-			urls[i] = r.URL
+		return resources, nil
+	}
+
+	// `--multi` が指定された場合は複数のリソースを選択させる
+	if multi {
+		selected, err := internal.SelectResources(resources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select resources: %w", err)
 		}
-		return urls, nil
+		return selected, nil
 	}
 
-	// 通常はユーザーに選択させそのリソースの URL を返す
+	// 通常はユーザーに選択させそのリソースを返す
 	selected, err := internal.SelectResource(resources)
 	if err != nil {
 		return nil, fmt.Errorf("failed to select resource: %w", err)
 	}
-	return []string{selected.URL}, nil
+	return []cloudflare.Resource{*selected}, nil
+}
+
+func outputResources(resources []cloudflare.Resource, opts options) error {
+	// `--format` が指定された場合はリソースの情報を標準出力に書き出す
+	if format := resolveFormat(opts); format != "" {
+		parsed, err := output.ParseFormat(format)
+		if err != nil {
+			return err
+		}
+		return output.Write(os.Stdout, resources, parsed, opts.template)
+	}
+
+	urls := make([]string, len(resources))
+	for i, r := range resources {
+		urls[i] = r.URL
+	}
+	return outputURLs(urls, opts.print)
+}
+
+// resolveFormat は出力形式を返す。`--template` のみが指定された場合はテンプレート形式とみなす
+func resolveFormat(opts options) string {
+	if opts.format == "" && opts.template != "" {
+		return string(output.FormatTemplate)
+	}
+	return opts.format
+}
+
+// validateOutputOptions はリソースを読み込む前に出力に関するオプションを検証する
+func validateOutputOptions(opts options) error {
+	format := resolveFormat(opts)
+	if format == "" {
+		return nil
+	}
+
+	parsed, err := output.ParseFormat(format)
+	if err != nil {
+		return err
+	}
+	if parsed == output.FormatTemplate && opts.template == "" {
+		return fmt.Errorf("--template is required for the template format")
+	}
+	if parsed != output.FormatTemplate && opts.template != "" {
+		return fmt.Errorf("--template can only be used with --format template")
+	}
+	return nil
 }
 
 func outputURLs(urls []string, printOnly bool) error {
@@ -82,11 +330,30 @@ func outputURLs(urls []string, printOnly bool) error {
 	return internal.OpenURLs(urls)
 }
 
+// `--verbose` が指定された場合のみ標準エラー出力にログを出力する
+func logVerbose(opts options, format string, args ...any) {
+	if !opts.verbose {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
 func init() {
-	rootCmd.Flags().StringVarP(&opts.wranglerConfig, "wrangler-config", "c", "", "Path to wrangler configuration file")
-	rootCmd.Flags().StringVar(&opts.accountID, "account-id", "", "Cloudflare account ID")
-	rootCmd.Flags().BoolVarP(&opts.all, "all", "a", false, "Open all resources in the browser")
-	rootCmd.Flags().BoolVarP(&opts.print, "print", "p", false, "Print URL to stdout instead of opening in browser")
+	rootCmd.PersistentFlags().StringVarP(&opts.wranglerConfig, "wrangler-config", "c", "", "Path to wrangler configuration file")
+	rootCmd.PersistentFlags().StringVar(&opts.accountID, "account-id", "", "Cloudflare account ID")
+	rootCmd.PersistentFlags().StringVarP(&opts.env, "env", "e", "", "Wrangler environment to use")
+	rootCmd.PersistentFlags().BoolVarP(&opts.all, "all", "a", false, "Open all resources in the browser")
+	rootCmd.PersistentFlags().BoolVarP(&opts.multi, "multi", "m", false, "Select multiple resources to open (space to toggle, enter to confirm)")
+	rootCmd.PersistentFlags().BoolVarP(&opts.print, "print", "p", false, "Print URL to stdout instead of opening in browser")
+	rootCmd.Flags().StringSliceVarP(&opts.types, "type", "t", nil, "Only include resources of the given type (repeatable)")
+	rootCmd.Flags().StringSliceVar(&opts.excludeTypes, "exclude-type", nil, "Exclude resources of the given type (repeatable)")
+	rootCmd.Flags().BoolVar(&opts.preview, "preview", false, "Open the preview resources (preview_id, preview_database_id and preview_bucket_name)")
+	rootCmd.Flags().BoolVarP(&opts.workspace, "workspace", "w", false, "Find every wrangler project in the workspace")
+	rootCmd.PersistentFlags().StringVarP(&opts.format, "format", "f", "", "Print resources in the given format instead of opening them (json, yaml, csv, markdown, template)")
+	rootCmd.PersistentFlags().StringVar(&opts.template, "template", "", "Go text/template executed for each resource with --format template")
+	rootCmd.PersistentFlags().BoolVar(&opts.verbose, "verbose", false, "Print how the environment and account ID were resolved")
+
+	registerCompletions()
 }
 
 func main() {
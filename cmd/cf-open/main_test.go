@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+)
+
+func TestRootCmd_ResourceTypeQuery(t *testing.T) {
+	t.Parallel()
+
+	// リソースの種類はサブコマンドに奪われず、位置引数のクエリとして解釈される
+	for _, resourceType := range cloudflare.AllResourceTypes {
+		t.Run(string(resourceType), func(t *testing.T) {
+			t.Parallel()
+
+			cmd, args, err := rootCmd.Find([]string{string(resourceType)})
+			if err != nil {
+				t.Fatalf("rootCmd.Find() error = %v", err)
+			}
+			if cmd != rootCmd {
+				t.Errorf("rootCmd.Find(%q) = %q, want root command", resourceType, cmd.Name())
+			}
+			if len(args) != 1 || args[0] != string(resourceType) {
+				t.Errorf("rootCmd.Find(%q) args = %v, want [%s]", resourceType, args, resourceType)
+			}
+		})
+	}
+}
+
+func TestRootCmd_WorkerQuery(t *testing.T) {
+	t.Parallel()
+
+	resources := []cloudflare.Resource{
+		{Type: cloudflare.ResourceTypeWorker, Name: "my-worker", ID: "my-worker"},
+		{Type: cloudflare.ResourceTypeKV, Name: "CACHE", ID: "kv-id"},
+	}
+
+	cmd, args, err := rootCmd.Find([]string{"worker"})
+	if err != nil {
+		t.Fatalf("rootCmd.Find() error = %v", err)
+	}
+	if cmd != rootCmd {
+		t.Fatalf("rootCmd.Find(\"worker\") = %q, want root command", cmd.Name())
+	}
+
+	matched := cloudflare.MatchResources(resources, args[0])
+	if len(matched) != 1 || matched[0].Type != cloudflare.ResourceTypeWorker {
+		t.Errorf("MatchResources(%q) = %+v, want the worker resource", args[0], matched)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+)
+
+var workerPageCmd = &cobra.Command{
+	Use:       "worker-page [page]",
+	Short:     "Open a page of the Worker in the dashboard",
+	Long:      fmt.Sprintf("Open a page of the Worker in the dashboard.\n\nAvailable pages: %s", strings.Join(workerPageNames(), ", ")),
+	Args:      cobra.MaximumNArgs(1),
+	ValidArgs: workerPageNames(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var page string
+		if len(args) > 0 {
+			page = args[0]
+		}
+		return runWorker(opts, page)
+	},
+}
+
+func runWorker(opts options, page string) error {
+	// ページが指定されなければすべてのページから選択させる
+	pages := cloudflare.WorkerPages
+	if page != "" {
+		workerPage, err := cloudflare.ParseWorkerPage(page)
+		if err != nil {
+			return err
+		}
+		pages = []cloudflare.WorkerPage{workerPage}
+	}
+
+	wranglerConfig, err := loadWranglerConfig(opts)
+	if err != nil {
+		return err
+	}
+
+	wranglerConfig, err = applyEnv(wranglerConfig, resolveEnvName(opts))
+	if err != nil {
+		return err
+	}
+
+	accountID, hasAccount := resolveAccountID(wranglerConfig, opts)
+
+	resources, err := cloudflare.GetWorkerPageResources(wranglerConfig, pages, accountID, hasAccount)
+	if err != nil {
+		return err
+	}
+
+	selected, err := selectResources(resources, opts.all, opts.multi)
+	if err != nil {
+		return err
+	}
+
+	return outputResources(selected, opts)
+}
+
+func workerPageNames() []string {
+	names := make([]string, len(cloudflare.WorkerPages))
+	for i, page := range cloudflare.WorkerPages {
+		names[i] = string(page)
+	}
+	return names
+}
+
+func init() {
+	rootCmd.AddCommand(workerPageCmd)
+}
@@ -2,6 +2,10 @@ package cloudflare
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
 
 	"github.com/mst-mkt/cf-open/internal/config"
 )
@@ -15,23 +19,106 @@ func BuildDashboardURL(accountID, path string, hasAccount bool) string {
 	return fmt.Sprintf("%s/%s/%s", baseURL, accountID, path)
 }
 
+// BuildZoneURL はゾーン配下のページの URL を返す
+//
+// ゾーン ID がわかる場合はそれを使い、ゾーン名しかわからない場合はゾーン名で開く
+func BuildZoneURL(accountID string, zone Zone, path string, hasAccount bool) string {
+	zoneRef := zone.ID
+	if zoneRef == "" {
+		zoneRef = zone.Name
+	}
+	return BuildDashboardURL(accountID, strings.TrimSuffix(fmt.Sprintf("%s/%s", zoneRef, path), "/"), hasAccount)
+}
+
+// buildZonePickerURL はダッシュボードでゾーンを選択させてからゾーン配下のページを開く URL を返す
+func buildZonePickerURL(accountID, path string, hasAccount bool) string {
+	account := ":account"
+	if hasAccount {
+		account = accountID
+	}
+	return fmt.Sprintf("%s/?to=/%s/:zone/%s", baseURL, account, path)
+}
+
+// workerPath は Worker の指定した環境のページのパスを返す
+func workerPath(name, environment string) string {
+	if environment == "" {
+		environment = "production"
+	}
+	return fmt.Sprintf("workers/services/view/%s/%s", name, environment)
+}
+
+// r2BucketPath は R2 バケットのページのパスを返す
+//
+// ジュリスディクションが指定されたバケットは `r2/<jurisdiction>/buckets/<name>` に置かれる
+func r2BucketPath(jurisdiction, bucketName string) string {
+	if jurisdiction == "" {
+		jurisdiction = "default"
+	}
+	return fmt.Sprintf("r2/%s/buckets/%s", jurisdiction, bucketName)
+}
+
+// kvPath は KV ネームスペースのページのパスを返す
+func kvPath(namespaceID string) string {
+	return fmt.Sprintf("workers/kv/namespaces/%s/metrics", namespaceID)
+}
+
+// d1Path は D1 データベースのページのパスを返す
+func d1Path(databaseID string) string {
+	return fmt.Sprintf("workers/d1/databases/%s/metrics", databaseID)
+}
+
+// r2Description は R2 バケットの表示名を返す。ジュリスディクションが指定されていればそれを付ける
+func r2Description(label, jurisdiction, bucketName string) string {
+	if jurisdiction == "" {
+		return fmt.Sprintf("%s: %s", label, bucketName)
+	}
+	return fmt.Sprintf("%s: %s [%s]", label, bucketName, jurisdiction)
+}
+
 func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, hasAccount bool) []Resource {
 	var resources []Resource
 
+	// Pages
+	if config.Name != "" && config.IsPages() {
+		pagesViews := []struct {
+			label string
+			path  string
+		}{
+			{label: "Pages", path: fmt.Sprintf("pages/view/%s", config.Name)},
+			{label: "Pages Deployments", path: fmt.Sprintf("pages/view/%s/deployments", config.Name)},
+			{label: "Pages Settings", path: fmt.Sprintf("pages/view/%s/settings/production", config.Name)},
+		}
+		for _, page := range pagesViews {
+			resources = append(resources, Resource{
+				Type:        ResourceTypePages,
+				Name:        config.Name,
+				ID:          config.Name,
+				Description: fmt.Sprintf("%s: %s", page.label, config.Name),
+				URL:         BuildDashboardURL(accountID, page.path, hasAccount),
+			})
+		}
+	}
+
 	// Workers
-	if config.Name != "" {
+	if config.Name != "" && !config.IsPages() {
+		description := fmt.Sprintf("Worker: %s", config.Name)
+		// Workers Static Assets を配信している場合は印を付ける
+		if config.Assets != nil {
+			description = fmt.Sprintf("Worker: %s (static assets)", config.Name)
+		}
+
 		workerURL := fmt.Sprintf("workers/services/view/%s/production", config.Name)
 		resources = append(resources, Resource{
 			Type:        ResourceTypeWorker,
 			Name:        config.Name,
 			ID:          config.Name,
-			Description: fmt.Sprintf("Worker: %s", config.Name),
+			Description: description,
 			URL:         BuildDashboardURL(accountID, workerURL, hasAccount),
 		})
 	}
 
 	// Workers Observability
-	if config.Name != "" && config.Observability != nil {
+	if config.Name != "" && !config.IsPages() && config.Observability != nil {
 		observabilityURL := fmt.Sprintf("workers/services/view/%s/production/observability", config.Name)
 		resources = append(resources, Resource{
 			Type:        ResourceTypeObservability,
@@ -43,7 +130,7 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 	}
 
 	// Workers Cron Triggers
-	if config.Name != "" && config.Triggers != nil && len(config.Triggers.Crons) > 0 {
+	if config.Name != "" && !config.IsPages() && config.Triggers != nil && len(config.Triggers.Crons) > 0 {
 		cronURL := fmt.Sprintf("workers/services/view/%s/production/settings#trigger-events", config.Name)
 		resources = append(resources, Resource{
 			Type:        ResourceTypeCronTriggers,
@@ -54,20 +141,125 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 		})
 	}
 
-	// Queues
-	if config.Queues != nil {
-		for _, producer := range config.Queues.Producers {
-			queueURL := fmt.Sprintf("workers/queues/%s/metrics", producer.Queue)
+	// Tail Workers
+	for _, consumer := range config.TailConsumers {
+		resources = append(resources, Resource{
+			Type:        ResourceTypeTailConsumer,
+			Name:        consumer.Service,
+			ID:          consumer.Service,
+			Description: fmt.Sprintf("Tail Consumer: %s", consumer.Service),
+			URL:         BuildDashboardURL(accountID, workerPath(consumer.Service, consumer.Environment), hasAccount),
+		})
+	}
+
+	// Logpush
+	if config.Name != "" && config.Logpush != nil && *config.Logpush {
+		logpushURL := "logs/logpush"
+		resources = append(resources, Resource{
+			Type:        ResourceTypeLogpush,
+			Name:        config.Name,
+			ID:          config.Name,
+			Description: fmt.Sprintf("Logpush: %s", config.Name),
+			URL:         BuildDashboardURL(accountID, logpushURL, hasAccount),
+		})
+	}
+
+	// Zones (routes / custom domains)
+	for _, zone := range collectZones(config.AllRoutes()) {
+		resources = append(resources, Resource{
+			Type:        ResourceTypeZone,
+			Name:        zone.Label(),
+			ID:          zone.Label(),
+			Description: fmt.Sprintf("Zone: %s", zone.Label()),
+			URL:         BuildZoneURL(accountID, zone, "", hasAccount),
+		})
+		resources = append(resources, Resource{
+			Type:        ResourceTypeZoneDNS,
+			Name:        zone.Label(),
+			ID:          zone.Label(),
+			Description: fmt.Sprintf("DNS: %s", zone.Label()),
+			URL:         BuildZoneURL(accountID, zone, "dns/records", hasAccount),
+		})
+		resources = append(resources, Resource{
+			Type:        ResourceTypeWorkersRoutes,
+			Name:        zone.Label(),
+			ID:          zone.Label(),
+			Description: fmt.Sprintf("Workers Routes: %s", zone.Label()),
+			URL:         BuildZoneURL(accountID, zone, "workers", hasAccount),
+		})
+	}
+
+	// Durable Objects
+	if config.DurableObjects != nil {
+		for _, binding := range config.DurableObjects.Bindings {
+			// 他の Worker で定義されたクラスはその Worker の Durable Objects を開く
+			hostWorker := binding.ScriptName
+			if hostWorker == "" && !config.IsPages() {
+				hostWorker = config.Name
+			}
+			if hostWorker == "" {
+				continue
+			}
+
+			description := fmt.Sprintf("Durable Object: %s", binding.ClassName)
+			if binding.ScriptName != "" && binding.ScriptName != config.Name {
+				description = fmt.Sprintf("Durable Object: %s (in %s)", binding.ClassName, binding.ScriptName)
+			} else if config.IsSQLiteDurableObject(binding.ClassName) {
+				description = fmt.Sprintf("Durable Object: %s (SQLite)", binding.ClassName)
+			}
+
+			durableObjectURL := workerPath(hostWorker, binding.Environment) + "/durable-objects"
 			resources = append(resources, Resource{
-				Type:        ResourceTypeQueue,
-				Name:        producer.Binding,
-				ID:          producer.Queue,
-				Description: fmt.Sprintf("Queue: %s", producer.Queue),
-				URL:         BuildDashboardURL(accountID, queueURL, hasAccount),
+				Type:        ResourceTypeDurableObject,
+				Name:        binding.Name,
+				ID:          binding.ClassName,
+				Description: description,
+				URL:         BuildDashboardURL(accountID, durableObjectURL, hasAccount),
 			})
 		}
 	}
 
+	// Service Bindings
+	for _, service := range config.Services {
+		var details []string
+		if service.Environment != "" {
+			details = append(details, fmt.Sprintf("environment: %s", service.Environment))
+		}
+		if service.Entrypoint != "" {
+			details = append(details, fmt.Sprintf("entrypoint: %s", service.Entrypoint))
+		}
+
+		description := fmt.Sprintf("Service: %s → %s", service.Binding, service.Service)
+		if len(details) > 0 {
+			description = fmt.Sprintf("%s (%s)", description, strings.Join(details, ", "))
+		}
+
+		resources = append(resources, Resource{
+			Type:        ResourceTypeService,
+			Name:        service.Binding,
+			ID:          service.Service,
+			Description: description,
+			URL:         BuildDashboardURL(accountID, workerPath(service.Service, service.Environment), hasAccount),
+		})
+	}
+
+	// Queues
+	for _, queue := range collectQueues(config.Queues) {
+		description := fmt.Sprintf("Queue: %s", queue.name)
+		if len(queue.roles) > 1 || queue.roles[0] != queueRoleProducer {
+			description = fmt.Sprintf("Queue: %s (%s)", queue.name, strings.Join(queue.roles, ", "))
+		}
+
+		queueURL := fmt.Sprintf("workers/queues/%s/metrics", queue.name)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeQueue,
+			Name:        queue.binding,
+			ID:          queue.name,
+			Description: description,
+			URL:         BuildDashboardURL(accountID, queueURL, hasAccount),
+		})
+	}
+
 	// Workflows
 	for _, workflow := range config.Workflows {
 		workflowURL := fmt.Sprintf("workers/workflows/%s/instances", workflow.Name)
@@ -106,19 +298,19 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 
 	// R2 Object Storage
 	for _, bucket := range config.R2Buckets {
-		r2URL := fmt.Sprintf("r2/default/buckets/%s", bucket.BucketName)
+		r2URL := r2BucketPath(bucket.Jurisdiction, bucket.BucketName)
 		resources = append(resources, Resource{
 			Type:        ResourceTypeR2,
 			Name:        bucket.Binding,
 			ID:          bucket.BucketName,
-			Description: fmt.Sprintf("R2: %s", bucket.BucketName),
+			Description: r2Description("R2", bucket.Jurisdiction, bucket.BucketName),
 			URL:         BuildDashboardURL(accountID, r2URL, hasAccount),
 		})
 	}
 
 	// Workers KV
 	for _, kv := range config.KVNamespaces {
-		kvURL := fmt.Sprintf("workers/kv/namespaces/%s/metrics", kv.ID)
+		kvURL := kvPath(kv.ID)
 		resources = append(resources, Resource{
 			Type:        ResourceTypeKV,
 			Name:        kv.Binding,
@@ -130,7 +322,7 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 
 	// D1 SQL Database
 	for _, db := range config.D1Databases {
-		d1URL := fmt.Sprintf("workers/d1/databases/%s/metrics", db.DatabaseID)
+		d1URL := d1Path(db.DatabaseID)
 		resources = append(resources, Resource{
 			Type:        ResourceTypeD1,
 			Name:        db.Binding,
@@ -140,6 +332,18 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 		})
 	}
 
+	// Hyperdrive
+	for _, hyperdrive := range config.Hyperdrive {
+		hyperdriveURL := fmt.Sprintf("workers/hyperdrive/%s", hyperdrive.ID)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeHyperdrive,
+			Name:        hyperdrive.Binding,
+			ID:          hyperdrive.ID,
+			Description: fmt.Sprintf("Hyperdrive: %s (%s)", hyperdrive.Binding, hyperdrive.ID),
+			URL:         BuildDashboardURL(accountID, hyperdriveURL, hasAccount),
+		})
+	}
+
 	// Pipelines
 	for _, pipeline := range config.Pipelines {
 		pipelineURL := fmt.Sprintf("pipelines/%s/overview", pipeline.Pipeline)
@@ -164,6 +368,36 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 		})
 	}
 
+	// Workers AI / AI Gateway
+	if config.AI != nil && config.AI.Binding != "" {
+		resources = append(resources, Resource{
+			Type:        ResourceTypeWorkersAI,
+			Name:        config.AI.Binding,
+			ID:          "workers-ai",
+			Description: "Workers AI",
+			URL:         BuildDashboardURL(accountID, "ai/workers-ai", hasAccount),
+		})
+		resources = append(resources, Resource{
+			Type:        ResourceTypeAIGateway,
+			Name:        config.AI.Binding,
+			ID:          "ai-gateway",
+			Description: "AI Gateway",
+			URL:         BuildDashboardURL(accountID, "ai/ai-gateway", hasAccount),
+		})
+	}
+
+	// Analytics Engine
+	for _, dataset := range config.AnalyticsEngine {
+		analyticsEngineURL := "workers/analytics-engine"
+		resources = append(resources, Resource{
+			Type:        ResourceTypeAnalyticsEngine,
+			Name:        dataset.Binding,
+			ID:          dataset.DatasetName(),
+			Description: fmt.Sprintf("Analytics Engine: %s", dataset.DatasetName()),
+			URL:         BuildDashboardURL(accountID, analyticsEngineURL, hasAccount),
+		})
+	}
+
 	// Secrets Store
 	seenStoreIDs := make(map[string]bool)
 	for _, secret := range config.SecretsStoreSecrets {
@@ -194,5 +428,194 @@ func GetResourcesFromConfig(config *config.WranglerConfig, accountID string, has
 		})
 	}
 
+	// Email Routing
+	emailZones := collectZones(config.AllRoutes())
+	for _, email := range config.SendEmail {
+		// 送信先は外部のアドレスであることが多いため表示にのみ使う
+		description := fmt.Sprintf("Send Email: %s", email.Name)
+		if destinations := email.Destinations(); len(destinations) > 0 {
+			description = fmt.Sprintf("Send Email: %s → %s", email.Name, strings.Join(destinations, ", "))
+		}
+
+		// Email Routing はゾーン単位の設定なので Worker のルートのゾーンが 1 つだけならそれを開き、
+		// ルートがないか複数のゾーンにまたがる場合はダッシュボードでゾーンを選択させる
+		emailRoutingPath := "email/routing/overview"
+		emailURL := buildZonePickerURL(accountID, emailRoutingPath, hasAccount)
+		if len(emailZones) == 1 {
+			emailURL = BuildZoneURL(accountID, emailZones[0], emailRoutingPath, hasAccount)
+		}
+
+		resources = append(resources, Resource{
+			Type:        ResourceTypeSendEmail,
+			Name:        email.Name,
+			ID:          email.Name,
+			Description: description,
+			URL:         emailURL,
+		})
+	}
+
+	// mTLS Certificates
+	for _, certificate := range config.MTLSCertificates {
+		mtlsURL := "mtls-certificates"
+		resources = append(resources, Resource{
+			Type:        ResourceTypeMTLSCertificate,
+			Name:        certificate.Binding,
+			ID:          certificate.CertificateID,
+			Description: fmt.Sprintf("mTLS Certificate: %s (%s)", certificate.Binding, certificate.CertificateID),
+			URL:         BuildDashboardURL(accountID, mtlsURL, hasAccount),
+		})
+	}
+
+	// Rate Limiting
+	if config.Name != "" && !config.IsPages() {
+		for _, rateLimit := range config.AllRateLimits() {
+			description := fmt.Sprintf("Rate Limit: %s", rateLimit.Name)
+			if rateLimit.Simple != nil {
+				description = fmt.Sprintf("Rate Limit: %s (%d requests / %ds)", rateLimit.Name, rateLimit.Simple.Limit, rateLimit.Simple.Period)
+			}
+
+			rateLimitURL := fmt.Sprintf("workers/services/view/%s/production/settings#rate-limiting", config.Name)
+			resources = append(resources, Resource{
+				Type:        ResourceTypeRateLimit,
+				Name:        rateLimit.Name,
+				ID:          rateLimit.NamespaceID,
+				Description: description,
+				URL:         BuildDashboardURL(accountID, rateLimitURL, hasAccount),
+			})
+		}
+	}
+
+	// Containers
+	for _, container := range config.Containers {
+		if container.Name == "" && config.Name == "" {
+			continue
+		}
+
+		applicationName := container.ApplicationName(config.Name)
+		containerURL := fmt.Sprintf("workers/containers/%s", applicationName)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeContainer,
+			Name:        container.ClassName,
+			ID:          applicationName,
+			Description: fmt.Sprintf("Container: %s", applicationName),
+			URL:         BuildDashboardURL(accountID, containerURL, hasAccount),
+		})
+	}
+
+	// Workers for Platforms
+	for _, namespace := range config.DispatchNamespaces {
+		namespaceURL := fmt.Sprintf("workers-for-platforms/view/%s", namespace.Namespace)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeDispatchNamespace,
+			Name:        namespace.Binding,
+			ID:          namespace.Namespace,
+			Description: fmt.Sprintf("Dispatch Namespace: %s", namespace.Namespace),
+			URL:         BuildDashboardURL(accountID, namespaceURL, hasAccount),
+		})
+	}
+
 	return resources
 }
+
+const (
+	queueRoleProducer        = "producer"
+	queueRoleConsumer        = "consumer"
+	queueRoleDeadLetterQueue = "dead letter queue"
+)
+
+type queueUsage struct {
+	name    string
+	binding string
+	roles   []string
+}
+
+// collectQueues は producers / consumers / dead letter queue をキュー名ごとにまとめる
+//
+// 同じ Worker が送信と受信の両方を行うキューは 1 つのリソースとして扱う
+// 受信のみのキューや dead letter queue にはバインディングがないため binding は空になる
+func collectQueues(queues *config.QueuesConfig) []queueUsage {
+	if queues == nil {
+		return nil
+	}
+
+	var usages []queueUsage
+	indexes := make(map[string]int)
+	add := func(name, binding, role string) {
+		if name == "" {
+			return
+		}
+		i, ok := indexes[name]
+		if !ok {
+			indexes[name] = len(usages)
+			usages = append(usages, queueUsage{name: name})
+			i = len(usages) - 1
+		}
+		if binding != "" && usages[i].binding == "" {
+			usages[i].binding = binding
+		}
+		if !slices.Contains(usages[i].roles, role) {
+			usages[i].roles = append(usages[i].roles, role)
+		}
+	}
+
+	for _, producer := range queues.Producers {
+		add(producer.Queue, producer.Binding, queueRoleProducer)
+	}
+	for _, consumer := range queues.Consumers {
+		add(consumer.Queue, "", queueRoleConsumer)
+	}
+	for _, consumer := range queues.Consumers {
+		add(consumer.DeadLetterQueue, "", queueRoleDeadLetterQueue)
+	}
+
+	return usages
+}
+
+// Zone はルートが属するゾーンを表す
+type Zone struct {
+	Name string
+	ID   string
+}
+
+// Label はゾーンの表示名を返す
+func (z Zone) Label() string {
+	if z.Name != "" {
+		return z.Name
+	}
+	return z.ID
+}
+
+// collectZones はルートからゾーンを重複なく取り出す
+//
+// `zone_id` / `zone_name` が指定されていないルートは、パターンのホスト名の登録可能ドメイン
+// (`api.example.co.uk` なら `example.co.uk`) をゾーン名とみなす。判別できない場合はゾーンに含めない
+func collectZones(routes []config.Route) []Zone {
+	var zones []Zone
+	seen := make(map[string]bool)
+	for _, route := range routes {
+		zone := Zone{Name: route.ZoneName, ID: route.ZoneID}
+		if zone.Name == "" && zone.ID == "" {
+			zone.Name = registrableDomain(route.Host())
+		}
+		if zone.Label() == "" || seen[zone.Label()] {
+			continue
+		}
+		seen[zone.Label()] = true
+		zones = append(zones, zone)
+	}
+	return zones
+}
+
+// registrableDomain は Public Suffix List に基づいてホスト名の登録可能ドメインを返す
+//
+// ホスト名が空の場合や、パブリックサフィックスそのものの場合は空文字列を返す
+func registrableDomain(host string) string {
+	if host == "" {
+		return ""
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(strings.ToLower(host))
+	if err != nil {
+		return ""
+	}
+	return domain
+}
@@ -1,6 +1,8 @@
 package cloudflare
 
 import (
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/mst-mkt/cf-open/internal/config"
@@ -43,6 +45,57 @@ func TestBuildDashboardURL(t *testing.T) {
 	}
 }
 
+func TestBuildZoneURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		zone       Zone
+		path       string
+		hasAccount bool
+		want       string
+	}{
+		{
+			name:       "zone_id と Account ID あり",
+			zone:       Zone{Name: "example.com", ID: "zone-id"},
+			path:       "dns/records",
+			hasAccount: true,
+			want:       "https://dash.cloudflare.com/abc123/zone-id/dns/records",
+		},
+		{
+			name:       "zone_id あり Account ID なし",
+			zone:       Zone{ID: "zone-id"},
+			path:       "",
+			hasAccount: false,
+			want:       "https://dash.cloudflare.com/?to=/:account/zone-id",
+		},
+		{
+			name:       "ゾーン名と Account ID あり",
+			zone:       Zone{Name: "example.com"},
+			path:       "workers",
+			hasAccount: true,
+			want:       "https://dash.cloudflare.com/abc123/example.com/workers",
+		},
+		{
+			name:       "ゾーン名のみ",
+			zone:       Zone{Name: "example.com"},
+			path:       "workers",
+			hasAccount: false,
+			want:       "https://dash.cloudflare.com/?to=/:account/example.com/workers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := BuildZoneURL("abc123", tt.zone, tt.path, tt.hasAccount)
+			if got != tt.want {
+				t.Errorf("BuildZoneURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetResourcesFromConfig(t *testing.T) {
 	t.Parallel()
 
@@ -80,6 +133,19 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				ResourceTypeObservability: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/observability",
 			},
 		},
+		{
+			name: "Pages プロジェクト",
+			config: &config.WranglerConfig{
+				Name:                "my-pages",
+				PagesBuildOutputDir: "./dist",
+				Observability:       &config.ObservabilityConfig{Enabled: true},
+				KVNamespaces:        []config.KVNamespace{{Binding: "KV", ID: "kv-id"}},
+			},
+			wantTypes: []ResourceType{ResourceTypePages, ResourceTypePages, ResourceTypePages, ResourceTypeKV},
+			wantURLs: map[ResourceType]string{
+				ResourceTypePages: "https://dash.cloudflare.com/acc/pages/view/my-pages",
+			},
+		},
 		{
 			name: "KV Namespace",
 			config: &config.WranglerConfig{
@@ -104,6 +170,18 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				ResourceTypeD1: "https://dash.cloudflare.com/acc/workers/d1/databases/d1-id-456/metrics",
 			},
 		},
+		{
+			name: "Hyperdrive",
+			config: &config.WranglerConfig{
+				Hyperdrive: []config.Hyperdrive{
+					{Binding: "HYPERDRIVE", ID: "hyperdrive-id-789"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeHyperdrive},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeHyperdrive: "https://dash.cloudflare.com/acc/workers/hyperdrive/hyperdrive-id-789",
+			},
+		},
 		{
 			name: "R2 Bucket",
 			config: &config.WranglerConfig{
@@ -116,6 +194,18 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				ResourceTypeR2: "https://dash.cloudflare.com/acc/r2/default/buckets/my-bucket",
 			},
 		},
+		{
+			name: "ジュリスディクションが指定された R2 Bucket",
+			config: &config.WranglerConfig{
+				R2Buckets: []config.R2Bucket{
+					{Binding: "EU_BUCKET", BucketName: "eu-bucket", Jurisdiction: "eu"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeR2},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeR2: "https://dash.cloudflare.com/acc/r2/eu/buckets/eu-bucket",
+			},
+		},
 		{
 			name: "Queue",
 			config: &config.WranglerConfig{
@@ -130,6 +220,31 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				ResourceTypeQueue: "https://dash.cloudflare.com/acc/workers/queues/my-queue/metrics",
 			},
 		},
+		{
+			name: "Queue - consumer と dead letter queue",
+			config: &config.WranglerConfig{
+				Queues: &config.QueuesConfig{
+					Consumers: []config.QueueConsumer{
+						{Queue: "my-queue", DeadLetterQueue: "my-dlq", MaxBatchSize: 10},
+					},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeQueue, ResourceTypeQueue},
+			wantURLs:  nil,
+		},
+		{
+			name: "Queue - 送信と受信の両方を行うキューはまとめる",
+			config: &config.WranglerConfig{
+				Queues: &config.QueuesConfig{
+					Producers: []config.QueueProducer{{Binding: "MY_QUEUE", Queue: "my-queue"}},
+					Consumers: []config.QueueConsumer{{Queue: "my-queue"}},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeQueue},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeQueue: "https://dash.cloudflare.com/acc/workers/queues/my-queue/metrics",
+			},
+		},
 		{
 			name: "Workflow",
 			config: &config.WranglerConfig{
@@ -154,6 +269,30 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				ResourceTypeVectorize: "https://dash.cloudflare.com/acc/ai/vectorize/my-index",
 			},
 		},
+		{
+			name: "Workers AI",
+			config: &config.WranglerConfig{
+				AI: &config.AIConfig{Binding: "AI"},
+			},
+			wantTypes: []ResourceType{ResourceTypeWorkersAI, ResourceTypeAIGateway},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeWorkersAI: "https://dash.cloudflare.com/acc/ai/workers-ai",
+				ResourceTypeAIGateway: "https://dash.cloudflare.com/acc/ai/ai-gateway",
+			},
+		},
+		{
+			name: "Analytics Engine",
+			config: &config.WranglerConfig{
+				AnalyticsEngine: []config.AnalyticsEngineDataset{
+					{Binding: "EVENTS", Dataset: "events_dataset"},
+					{Binding: "METRICS"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeAnalyticsEngine, ResourceTypeAnalyticsEngine},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeAnalyticsEngine: "https://dash.cloudflare.com/acc/workers/analytics-engine",
+			},
+		},
 		{
 			name: "Pipeline",
 			config: &config.WranglerConfig{
@@ -247,6 +386,229 @@ func TestGetResourcesFromConfig(t *testing.T) {
 			wantTypes: nil,
 			wantURLs:  nil,
 		},
+		{
+			name: "Tail Consumer",
+			config: &config.WranglerConfig{
+				TailConsumers: []config.TailConsumer{
+					{Service: "tail-worker"},
+					{Service: "tail-worker-staging", Environment: "staging"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeTailConsumer, ResourceTypeTailConsumer},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeTailConsumer: "https://dash.cloudflare.com/acc/workers/services/view/tail-worker/production",
+			},
+		},
+		{
+			name: "Logpush",
+			config: &config.WranglerConfig{
+				Name:    "my-worker",
+				Logpush: boolPtr(true),
+			},
+			wantTypes: []ResourceType{ResourceTypeWorker, ResourceTypeLogpush},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeLogpush: "https://dash.cloudflare.com/acc/logs/logpush",
+			},
+		},
+		{
+			name: "Logpush - false の場合は表示しない",
+			config: &config.WranglerConfig{
+				Name:    "my-worker",
+				Logpush: boolPtr(false),
+			},
+			wantTypes: []ResourceType{ResourceTypeWorker},
+			wantURLs:  nil,
+		},
+		{
+			name: "Send Email",
+			config: &config.WranglerConfig{
+				SendEmail: []config.SendEmailBinding{
+					{Name: "EMAIL", DestinationAddress: "alerts@example.com"},
+					{Name: "ALLOWED", AllowedDestinationAddresses: []string{"team@example.org"}},
+					{Name: "ANY"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeSendEmail, ResourceTypeSendEmail, ResourceTypeSendEmail},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeSendEmail: "https://dash.cloudflare.com/?to=/acc/:zone/email/routing/overview",
+			},
+		},
+		{
+			name: "Routes - zone_id がある場合",
+			config: &config.WranglerConfig{
+				Routes: []config.Route{
+					{Pattern: "shop.example.net/*", ZoneID: "zone-id-123"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeZone, ResourceTypeZoneDNS, ResourceTypeWorkersRoutes},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeZone:          "https://dash.cloudflare.com/acc/zone-id-123",
+				ResourceTypeZoneDNS:       "https://dash.cloudflare.com/acc/zone-id-123/dns/records",
+				ResourceTypeWorkersRoutes: "https://dash.cloudflare.com/acc/zone-id-123/workers",
+			},
+		},
+		{
+			name: "Routes - zone_name の場合",
+			config: &config.WranglerConfig{
+				Route: &config.Route{Pattern: "api.example.com/*", ZoneName: "example.com"},
+			},
+			wantTypes: []ResourceType{ResourceTypeZone, ResourceTypeZoneDNS, ResourceTypeWorkersRoutes},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeZone:          "https://dash.cloudflare.com/acc/example.com",
+				ResourceTypeZoneDNS:       "https://dash.cloudflare.com/acc/example.com/dns/records",
+				ResourceTypeWorkersRoutes: "https://dash.cloudflare.com/acc/example.com/workers",
+			},
+		},
+		{
+			name: "Routes - 同じゾーンはまとめる",
+			config: &config.WranglerConfig{
+				Routes: []config.Route{
+					{Pattern: "example.com/*"},
+					{Pattern: "*.example.com/*"},
+					{Pattern: "example.com/api/*", ZoneName: "example.com"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeZone, ResourceTypeZoneDNS, ResourceTypeWorkersRoutes},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeZone: "https://dash.cloudflare.com/acc/example.com",
+			},
+		},
+		{
+			name: "Durable Object",
+			config: &config.WranglerConfig{
+				Name: "my-worker",
+				DurableObjects: &config.DurableObjectsConfig{
+					Bindings: []config.DurableObjectBinding{
+						{Name: "COUNTER", ClassName: "Counter"},
+					},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeWorker, ResourceTypeDurableObject},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeDurableObject: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/durable-objects",
+			},
+		},
+		{
+			name: "Durable Object - 他の Worker で定義されたクラス",
+			config: &config.WranglerConfig{
+				DurableObjects: &config.DurableObjectsConfig{
+					Bindings: []config.DurableObjectBinding{
+						{Name: "ROOM", ClassName: "ChatRoom", ScriptName: "chat-worker"},
+					},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeDurableObject},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeDurableObject: "https://dash.cloudflare.com/acc/workers/services/view/chat-worker/production/durable-objects",
+			},
+		},
+		{
+			name: "Durable Object - 他の Worker の environment を指定",
+			config: &config.WranglerConfig{
+				DurableObjects: &config.DurableObjectsConfig{
+					Bindings: []config.DurableObjectBinding{
+						{Name: "ROOM", ClassName: "ChatRoom", ScriptName: "chat-worker", Environment: "staging"},
+					},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeDurableObject},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeDurableObject: "https://dash.cloudflare.com/acc/workers/services/view/chat-worker/staging/durable-objects",
+			},
+		},
+		{
+			name: "Durable Object - Worker 名も script_name もない場合は表示しない",
+			config: &config.WranglerConfig{
+				DurableObjects: &config.DurableObjectsConfig{
+					Bindings: []config.DurableObjectBinding{
+						{Name: "COUNTER", ClassName: "Counter"},
+					},
+				},
+			},
+			wantTypes: nil,
+			wantURLs:  nil,
+		},
+		{
+			name: "Service Binding",
+			config: &config.WranglerConfig{
+				Services: []config.ServiceBinding{
+					{Binding: "AUTH", Service: "auth-worker"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeService},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeService: "https://dash.cloudflare.com/acc/workers/services/view/auth-worker/production",
+			},
+		},
+		{
+			name: "Service Binding - environment を指定",
+			config: &config.WranglerConfig{
+				Services: []config.ServiceBinding{
+					{Binding: "AUTH", Service: "auth-worker", Environment: "staging", Entrypoint: "AuthEntrypoint"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeService},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeService: "https://dash.cloudflare.com/acc/workers/services/view/auth-worker/staging",
+			},
+		},
+		{
+			name: "mTLS Certificate",
+			config: &config.WranglerConfig{
+				MTLSCertificates: []config.MTLSCertificate{
+					{Binding: "MY_CERT", CertificateID: "cert-id-123"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeMTLSCertificate},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeMTLSCertificate: "https://dash.cloudflare.com/acc/mtls-certificates",
+			},
+		},
+		{
+			name: "Rate Limiting - ratelimits と unsafe.bindings",
+			config: &config.WranglerConfig{
+				Name: "my-worker",
+				RateLimits: []config.RateLimit{
+					{Name: "LIMITER", NamespaceID: "1001", Simple: &config.RateLimitSimple{Limit: 100, Period: 60}},
+				},
+				Unsafe: &config.UnsafeConfig{
+					Bindings: []config.UnsafeBinding{
+						{Name: "LEGACY_LIMITER", Type: "ratelimit", NamespaceID: "1002"},
+						{Name: "OTHER", Type: "other"},
+					},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeWorker, ResourceTypeRateLimit, ResourceTypeRateLimit},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeRateLimit: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/settings#rate-limiting",
+			},
+		},
+		{
+			name: "Container",
+			config: &config.WranglerConfig{
+				Name: "my-worker",
+				Containers: []config.Container{
+					{ClassName: "MyContainer", Image: "./Dockerfile"},
+					{ClassName: "Named", Image: "./Dockerfile", Name: "named-app"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeWorker, ResourceTypeContainer, ResourceTypeContainer},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeContainer: "https://dash.cloudflare.com/acc/workers/containers/my-worker-mycontainer",
+			},
+		},
+		{
+			name: "Dispatch Namespace",
+			config: &config.WranglerConfig{
+				DispatchNamespaces: []config.DispatchNamespace{
+					{Binding: "DISPATCHER", Namespace: "customers"},
+				},
+			},
+			wantTypes: []ResourceType{ResourceTypeDispatchNamespace},
+			wantURLs: map[ResourceType]string{
+				ResourceTypeDispatchNamespace: "https://dash.cloudflare.com/acc/workers-for-platforms/view/customers",
+			},
+		},
 		{
 			name: "全リソース",
 			config: &config.WranglerConfig{
@@ -254,11 +616,16 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				Observability: &config.ObservabilityConfig{Enabled: true},
 				KVNamespaces:  []config.KVNamespace{{Binding: "KV", ID: "kv-id"}},
 				D1Databases:   []config.D1Database{{Binding: "DB", DatabaseName: "db", DatabaseID: "d1-id"}},
+				Hyperdrive:    []config.Hyperdrive{{Binding: "HYPERDRIVE", ID: "hyperdrive-id"}},
 				R2Buckets:     []config.R2Bucket{{Binding: "R2", BucketName: "bucket"}},
 				Queues:        &config.QueuesConfig{Producers: []config.QueueProducer{{Binding: "Q", Queue: "queue"}}},
 				Workflows:     []config.Workflow{{Binding: "WF", Name: "workflow", ClassName: "WF"}},
 				Vectorize:     []config.VectorizeIndex{{Binding: "VEC", IndexName: "index"}},
 				Pipelines:     []config.Pipeline{{Binding: "PIPE", Pipeline: "pipeline"}},
+				AI:            &config.AIConfig{Binding: "AI"},
+				AnalyticsEngine: []config.AnalyticsEngineDataset{
+					{Binding: "AE", Dataset: "dataset"},
+				},
 				SecretsStoreSecrets: []config.SecretsStoreSecret{
 					{Binding: "SEC", StoreID: "store", SecretName: "secret"},
 				},
@@ -266,11 +633,42 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				Images:      &config.ImagesConfig{Binding: "IMAGES"},
 				VPCServices: []config.VPCService{{Binding: "VPC", ServiceID: "vpc"}},
 				Triggers:    &config.TriggersConfig{Crons: []string{"* * * * *"}},
+				TailConsumers: []config.TailConsumer{
+					{Service: "tail"},
+				},
+				Logpush: boolPtr(true),
+				SendEmail: []config.SendEmailBinding{
+					{Name: "EMAIL", DestinationAddress: "to@example.com"},
+				},
+				DurableObjects: &config.DurableObjectsConfig{
+					Bindings: []config.DurableObjectBinding{{Name: "DO", ClassName: "DO"}},
+				},
+				Services: []config.ServiceBinding{{Binding: "SVC", Service: "svc"}},
+				Routes:   []config.Route{{Pattern: "example.com/*", ZoneName: "example.com"}},
+				MTLSCertificates: []config.MTLSCertificate{
+					{Binding: "CERT", CertificateID: "cert"},
+				},
+				RateLimits: []config.RateLimit{
+					{Name: "LIMITER", NamespaceID: "1001"},
+				},
+				Containers: []config.Container{
+					{ClassName: "Container", Image: "./Dockerfile"},
+				},
+				DispatchNamespaces: []config.DispatchNamespace{
+					{Binding: "DISPATCHER", Namespace: "namespace"},
+				},
 			},
 			wantTypes: []ResourceType{
 				ResourceTypeWorker,
 				ResourceTypeObservability,
 				ResourceTypeCronTriggers,
+				ResourceTypeTailConsumer,
+				ResourceTypeLogpush,
+				ResourceTypeZone,
+				ResourceTypeZoneDNS,
+				ResourceTypeWorkersRoutes,
+				ResourceTypeDurableObject,
+				ResourceTypeService,
 				ResourceTypeQueue,
 				ResourceTypeWorkflow,
 				ResourceTypeBrowserRendering,
@@ -278,10 +676,19 @@ func TestGetResourcesFromConfig(t *testing.T) {
 				ResourceTypeR2,
 				ResourceTypeKV,
 				ResourceTypeD1,
+				ResourceTypeHyperdrive,
 				ResourceTypePipeline,
 				ResourceTypeVectorize,
+				ResourceTypeWorkersAI,
+				ResourceTypeAIGateway,
+				ResourceTypeAnalyticsEngine,
 				ResourceTypeSecretsStore,
 				ResourceTypeImages,
+				ResourceTypeSendEmail,
+				ResourceTypeMTLSCertificate,
+				ResourceTypeRateLimit,
+				ResourceTypeContainer,
+				ResourceTypeDispatchNamespace,
 			},
 			wantURLs: nil,
 		},
@@ -318,6 +725,385 @@ func TestGetResourcesFromConfig(t *testing.T) {
 	}
 }
 
+func TestCollectZones(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		routes []config.Route
+		want   []Zone
+	}{
+		{
+			name:   "サブドメインのルートは登録可能ドメインをゾーンとする",
+			routes: []config.Route{{Pattern: "api.example.com/*"}},
+			want:   []Zone{{Name: "example.com"}},
+		},
+		{
+			name:   "カスタムドメイン",
+			routes: []config.Route{{Pattern: "app.example.com", CustomDomain: true}},
+			want:   []Zone{{Name: "example.com"}},
+		},
+		{
+			name:   "複数のラベルからなるパブリックサフィックス",
+			routes: []config.Route{{Pattern: "https://shop.example.co.uk/*"}},
+			want:   []Zone{{Name: "example.co.uk"}},
+		},
+		{
+			name:   "同じゾーンのルートはまとめる",
+			routes: []config.Route{{Pattern: "api.example.com/*"}, {Pattern: "*.example.com/*"}, {Pattern: "example.com/*"}},
+			want:   []Zone{{Name: "example.com"}},
+		},
+		{
+			name:   "zone_name が指定されていればそれを使う",
+			routes: []config.Route{{Pattern: "api.dev.example.com/*", ZoneName: "dev.example.com"}},
+			want:   []Zone{{Name: "dev.example.com"}},
+		},
+		{
+			name:   "ゾーンを判別できないルートは含めない",
+			routes: []config.Route{{Pattern: "co.uk/*"}, {Pattern: "localhost/*"}, {Pattern: "/*"}},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := collectZones(tt.routes)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("collectZones() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetResourcesFromConfig_DurableObjectDescription(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		Name: "my-worker",
+		DurableObjects: &config.DurableObjectsConfig{
+			Bindings: []config.DurableObjectBinding{
+				{Name: "COUNTER", ClassName: "Counter"},
+				{Name: "LEGACY", ClassName: "Legacy"},
+				{Name: "ROOM", ClassName: "ChatRoom", ScriptName: "chat-worker"},
+			},
+		},
+		Migrations: []config.Migration{
+			{Tag: "v1", NewSqliteClasses: []string{"Counter"}, NewClasses: []string{"Legacy"}},
+		},
+	}
+
+	want := []string{
+		"Worker: my-worker",
+		"Durable Object: Counter (SQLite)",
+		"Durable Object: Legacy",
+		"Durable Object: ChatRoom (in chat-worker)",
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Description != want[i] {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i])
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_ServiceDescription(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		Services: []config.ServiceBinding{
+			{Binding: "AUTH", Service: "auth-worker"},
+			{Binding: "AUTH_STAGING", Service: "auth-worker", Environment: "staging"},
+			{Binding: "RPC", Service: "rpc-worker", Entrypoint: "RPCEntrypoint"},
+		},
+	}
+
+	want := []string{
+		"Service: AUTH → auth-worker",
+		"Service: AUTH_STAGING → auth-worker (environment: staging)",
+		"Service: RPC → rpc-worker (entrypoint: RPCEntrypoint)",
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Description != want[i] {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i])
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_R2Description(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		R2Buckets: []config.R2Bucket{
+			{Binding: "BUCKET", BucketName: "bucket"},
+			{Binding: "EU_BUCKET", BucketName: "eu-bucket", Jurisdiction: "eu"},
+			{Binding: "FEDRAMP_BUCKET", BucketName: "fedramp-bucket", Jurisdiction: "fedramp"},
+		},
+	}
+
+	want := []string{
+		"R2: bucket",
+		"R2: eu-bucket [eu]",
+		"R2: fedramp-bucket [fedramp]",
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Description != want[i] {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i])
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_Queues(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		Queues: &config.QueuesConfig{
+			Producers: []config.QueueProducer{
+				{Binding: "JOBS", Queue: "jobs"},
+				{Binding: "EVENTS", Queue: "events"},
+			},
+			Consumers: []config.QueueConsumer{
+				{Queue: "jobs", DeadLetterQueue: "jobs-dlq"},
+				{Queue: "emails", DeadLetterQueue: "jobs-dlq"},
+			},
+		},
+	}
+
+	want := []Resource{
+		{Name: "JOBS", ID: "jobs", Description: "Queue: jobs (producer, consumer)", URL: "https://dash.cloudflare.com/acc/workers/queues/jobs/metrics"},
+		{Name: "EVENTS", ID: "events", Description: "Queue: events", URL: "https://dash.cloudflare.com/acc/workers/queues/events/metrics"},
+		{ID: "emails", Description: "Queue: emails (consumer)", URL: "https://dash.cloudflare.com/acc/workers/queues/emails/metrics"},
+		{ID: "jobs-dlq", Description: "Queue: jobs-dlq (dead letter queue)", URL: "https://dash.cloudflare.com/acc/workers/queues/jobs-dlq/metrics"},
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		want[i].Type = ResourceTypeQueue
+		if r != want[i] {
+			t.Errorf("resources[%d] = %+v, want %+v", i, r, want[i])
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_AnalyticsEngineDescription(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		AnalyticsEngine: []config.AnalyticsEngineDataset{
+			{Binding: "EVENTS", Dataset: "events_dataset"},
+			{Binding: "METRICS"},
+		},
+	}
+
+	want := []string{
+		"Analytics Engine: events_dataset",
+		"Analytics Engine: METRICS",
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Description != want[i] {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i])
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_Pages(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		Name:                "my-pages",
+		PagesBuildOutputDir: "./dist",
+	}
+
+	want := []Resource{
+		{Description: "Pages: my-pages", URL: "https://dash.cloudflare.com/acc/pages/view/my-pages"},
+		{Description: "Pages Deployments: my-pages", URL: "https://dash.cloudflare.com/acc/pages/view/my-pages/deployments"},
+		{Description: "Pages Settings: my-pages", URL: "https://dash.cloudflare.com/acc/pages/view/my-pages/settings/production"},
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Type != ResourceTypePages {
+			t.Errorf("resources[%d].Type = %q, want %q", i, r.Type, ResourceTypePages)
+		}
+		if r.Description != want[i].Description {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i].Description)
+		}
+		if r.URL != want[i].URL {
+			t.Errorf("resources[%d].URL = %q, want %q", i, r.URL, want[i].URL)
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_StaticAssets(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		Name:   "my-worker",
+		Assets: &config.AssetsConfig{Directory: "./public", Binding: "ASSETS"},
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != 1 {
+		t.Fatalf("リソース数 = %d, want 1", len(resources))
+	}
+	if want := "Worker: my-worker (static assets)"; resources[0].Description != want {
+		t.Errorf("Description = %q, want %q", resources[0].Description, want)
+	}
+}
+
+func TestGetResourcesFromConfig_SendEmail(t *testing.T) {
+	t.Parallel()
+
+	bindings := []config.SendEmailBinding{
+		{Name: "ALERTS", DestinationAddress: "alerts@gmail.com"},
+		{Name: "TEAM", AllowedDestinationAddresses: []string{"a@example.org", "b@example.net"}},
+		{Name: "ANY"},
+	}
+
+	tests := []struct {
+		name    string
+		config  *config.WranglerConfig
+		wantURL string
+	}{
+		{
+			name:    "ルートがない場合はゾーンを選択させる",
+			config:  &config.WranglerConfig{Name: "my-worker", SendEmail: bindings},
+			wantURL: "https://dash.cloudflare.com/?to=/acc/:zone/email/routing/overview",
+		},
+		{
+			name: "ルートがある場合はそのゾーンを開く",
+			config: &config.WranglerConfig{
+				Name:      "my-worker",
+				Routes:    []config.Route{{Pattern: "api.example.com/*", ZoneID: "zone-id"}},
+				SendEmail: bindings,
+			},
+			wantURL: "https://dash.cloudflare.com/acc/zone-id/email/routing/overview",
+		},
+		{
+			name: "ルートが複数のゾーンにまたがる場合はゾーンを選択させる",
+			config: &config.WranglerConfig{
+				Name: "my-worker",
+				Routes: []config.Route{
+					{Pattern: "api.example.com/*", ZoneID: "zone-id"},
+					{Pattern: "api.example.org/*", ZoneName: "example.org"},
+				},
+				SendEmail: bindings,
+			},
+			wantURL: "https://dash.cloudflare.com/?to=/acc/:zone/email/routing/overview",
+		},
+	}
+
+	// 外部の送信先アドレスのドメインは URL に使わず、説明にのみ表示する
+	wantDescriptions := []string{
+		"Send Email: ALERTS → alerts@gmail.com",
+		"Send Email: TEAM → a@example.org, b@example.net",
+		"Send Email: ANY",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var emails []Resource
+			for _, r := range GetResourcesFromConfig(tt.config, "acc", true) {
+				if r.Type == ResourceTypeSendEmail {
+					emails = append(emails, r)
+				}
+			}
+			if len(emails) != len(wantDescriptions) {
+				t.Fatalf("Send Email のリソース数 = %d, want %d", len(emails), len(wantDescriptions))
+			}
+			for i, r := range emails {
+				if r.Description != wantDescriptions[i] {
+					t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, wantDescriptions[i])
+				}
+				if r.URL != tt.wantURL {
+					t.Errorf("resources[%d].URL = %q, want %q", i, r.URL, tt.wantURL)
+				}
+				if strings.Contains(r.URL, "gmail.com") {
+					t.Errorf("resources[%d].URL = %q, should not contain the destination domain", i, r.URL)
+				}
+			}
+		})
+	}
+}
+
+func TestGetResourcesFromConfig_RateLimitDescription(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{
+		Name: "my-worker",
+		RateLimits: []config.RateLimit{
+			{Name: "LIMITER", NamespaceID: "1001", Simple: &config.RateLimitSimple{Limit: 100, Period: 60}},
+			{Name: "NO_SIMPLE", NamespaceID: "1002"},
+		},
+	}
+
+	want := []string{
+		"Worker: my-worker",
+		"Rate Limit: LIMITER (100 requests / 60s)",
+		"Rate Limit: NO_SIMPLE",
+	}
+
+	resources := GetResourcesFromConfig(cfg, "acc", true)
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Description != want[i] {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i])
+		}
+	}
+}
+
+func TestGetResourcesFromConfig_RateLimitPages(t *testing.T) {
+	t.Parallel()
+
+	// Pages プロジェクトには Worker の設定ページがないため Rate Limit のリソースを作らない
+	cfg := &config.WranglerConfig{
+		Name:                "my-pages",
+		PagesBuildOutputDir: "./dist",
+		RateLimits: []config.RateLimit{
+			{Name: "LIMITER", NamespaceID: "1001", Simple: &config.RateLimitSimple{Limit: 100, Period: 60}},
+		},
+	}
+
+	for _, r := range GetResourcesFromConfig(cfg, "acc", true) {
+		if r.Type == ResourceTypeRateLimit {
+			t.Errorf("unexpected rate limit resource for Pages project: %+v", r)
+		}
+		if strings.Contains(r.URL, "workers/services/view/") {
+			t.Errorf("unexpected Worker URL for Pages project: %s", r.URL)
+		}
+	}
+}
+
 func TestGetResourcesFromConfig_NoAccountID(t *testing.T) {
 	t.Parallel()
 
@@ -326,13 +1112,17 @@ func TestGetResourcesFromConfig_NoAccountID(t *testing.T) {
 		KVNamespaces: []config.KVNamespace{
 			{Binding: "KV", ID: "kv-id"},
 		},
+		Hyperdrive: []config.Hyperdrive{
+			{Binding: "HYPERDRIVE", ID: "hyperdrive-id"},
+		},
 	}
 
 	resources := GetResourcesFromConfig(cfg, "", false)
 
 	expectedURLs := map[ResourceType]string{
-		ResourceTypeWorker: "https://dash.cloudflare.com/?to=/:account/workers/services/view/my-worker/production",
-		ResourceTypeKV:     "https://dash.cloudflare.com/?to=/:account/workers/kv/namespaces/kv-id/metrics",
+		ResourceTypeWorker:     "https://dash.cloudflare.com/?to=/:account/workers/services/view/my-worker/production",
+		ResourceTypeKV:         "https://dash.cloudflare.com/?to=/:account/workers/kv/namespaces/kv-id/metrics",
+		ResourceTypeHyperdrive: "https://dash.cloudflare.com/?to=/:account/workers/hyperdrive/hyperdrive-id",
 	}
 
 	for _, r := range resources {
@@ -343,3 +1133,7 @@ func TestGetResourcesFromConfig_NoAccountID(t *testing.T) {
 		}
 	}
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
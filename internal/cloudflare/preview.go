@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"fmt"
+
+	"github.com/mst-mkt/cf-open/internal/config"
+)
+
+// GetPreviewResourcesFromConfig は `wrangler dev --remote` などで使われるプレビュー用のリソースを返す
+//
+// KV の `preview_id`、D1 の `preview_database_id`、R2 の `preview_bucket_name` を使い、
+// プレビュー用の識別子を持たないリソースは含めない
+func GetPreviewResourcesFromConfig(config *config.WranglerConfig, accountID string, hasAccount bool) []Resource {
+	var resources []Resource
+
+	// R2 Object Storage
+	for _, bucket := range config.R2Buckets {
+		if bucket.PreviewBucketName == "" {
+			continue
+		}
+
+		r2URL := r2BucketPath(bucket.Jurisdiction, bucket.PreviewBucketName)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeR2,
+			Name:        bucket.Binding,
+			ID:          bucket.PreviewBucketName,
+			Description: r2Description("R2 (preview)", bucket.Jurisdiction, bucket.PreviewBucketName),
+			URL:         BuildDashboardURL(accountID, r2URL, hasAccount),
+		})
+	}
+
+	// Workers KV
+	for _, kv := range config.KVNamespaces {
+		if kv.PreviewID == "" {
+			continue
+		}
+
+		kvURL := kvPath(kv.PreviewID)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeKV,
+			Name:        kv.Binding,
+			ID:          kv.PreviewID,
+			Description: fmt.Sprintf("KV (preview): %s (%s)", kv.Binding, kv.PreviewID),
+			URL:         BuildDashboardURL(accountID, kvURL, hasAccount),
+		})
+	}
+
+	// D1 SQL Database
+	for _, db := range config.D1Databases {
+		if db.PreviewDatabaseID == "" {
+			continue
+		}
+
+		d1URL := d1Path(db.PreviewDatabaseID)
+		resources = append(resources, Resource{
+			Type:        ResourceTypeD1,
+			Name:        db.Binding,
+			ID:          db.PreviewDatabaseID,
+			Description: fmt.Sprintf("D1 (preview): %s (%s)", db.DatabaseName, db.PreviewDatabaseID),
+			URL:         BuildDashboardURL(accountID, d1URL, hasAccount),
+		})
+	}
+
+	return resources
+}
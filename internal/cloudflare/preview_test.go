@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/mst-mkt/cf-open/internal/config"
+)
+
+func TestGetPreviewResourcesFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config *config.WranglerConfig
+		want   []Resource
+	}{
+		{
+			name:   "空の設定",
+			config: &config.WranglerConfig{},
+			want:   nil,
+		},
+		{
+			name: "プレビュー用の識別子を使う",
+			config: &config.WranglerConfig{
+				Name:         "my-worker",
+				R2Buckets:    []config.R2Bucket{{Binding: "BUCKET", BucketName: "bucket", PreviewBucketName: "bucket-preview"}},
+				KVNamespaces: []config.KVNamespace{{Binding: "KV", ID: "kv-id", PreviewID: "kv-preview-id"}},
+				D1Databases:  []config.D1Database{{Binding: "DB", DatabaseName: "db", DatabaseID: "d1-id", PreviewDatabaseID: "d1-preview-id"}},
+			},
+			want: []Resource{
+				{
+					Type:        ResourceTypeR2,
+					Name:        "BUCKET",
+					ID:          "bucket-preview",
+					Description: "R2 (preview): bucket-preview",
+					URL:         "https://dash.cloudflare.com/acc/r2/default/buckets/bucket-preview",
+				},
+				{
+					Type:        ResourceTypeKV,
+					Name:        "KV",
+					ID:          "kv-preview-id",
+					Description: "KV (preview): KV (kv-preview-id)",
+					URL:         "https://dash.cloudflare.com/acc/workers/kv/namespaces/kv-preview-id/metrics",
+				},
+				{
+					Type:        ResourceTypeD1,
+					Name:        "DB",
+					ID:          "d1-preview-id",
+					Description: "D1 (preview): db (d1-preview-id)",
+					URL:         "https://dash.cloudflare.com/acc/workers/d1/databases/d1-preview-id/metrics",
+				},
+			},
+		},
+		{
+			name: "ジュリスディクションが指定された R2 Bucket",
+			config: &config.WranglerConfig{
+				R2Buckets: []config.R2Bucket{{Binding: "BUCKET", BucketName: "bucket", PreviewBucketName: "bucket-preview", Jurisdiction: "eu"}},
+			},
+			want: []Resource{
+				{
+					Type:        ResourceTypeR2,
+					Name:        "BUCKET",
+					ID:          "bucket-preview",
+					Description: "R2 (preview): bucket-preview [eu]",
+					URL:         "https://dash.cloudflare.com/acc/r2/eu/buckets/bucket-preview",
+				},
+			},
+		},
+		{
+			name: "プレビュー用の識別子がないリソースは含めない",
+			config: &config.WranglerConfig{
+				KVNamespaces: []config.KVNamespace{
+					{Binding: "KV", ID: "kv-id"},
+					{Binding: "KV_PREVIEW", ID: "kv-id-2", PreviewID: "kv-preview-id"},
+				},
+				D1Databases: []config.D1Database{{Binding: "DB", DatabaseName: "db", DatabaseID: "d1-id"}},
+			},
+			want: []Resource{
+				{
+					Type:        ResourceTypeKV,
+					Name:        "KV_PREVIEW",
+					ID:          "kv-preview-id",
+					Description: "KV (preview): KV_PREVIEW (kv-preview-id)",
+					URL:         "https://dash.cloudflare.com/acc/workers/kv/namespaces/kv-preview-id/metrics",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := GetPreviewResourcesFromConfig(tt.config, "acc", true)
+			if len(got) != len(tt.want) {
+				t.Fatalf("リソース数 = %d, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("resources[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MatchResources はクエリに一致するリソースを返す
+//
+// クエリはリソースの種類・バインディング名・ID のいずれかと大文字小文字を区別せずに照合する。
+// `kv:CACHE` のように `<種類>:<名前>` の形式で指定した場合は、その種類のリソースのみを名前か ID で照合する
+func MatchResources(resources []Resource, query string) []Resource {
+	if resourceType, name, ok := strings.Cut(query, ":"); ok && hasResourceType(resources, resourceType) {
+		var matched []Resource
+		for _, r := range resources {
+			if !strings.EqualFold(string(r.Type), resourceType) {
+				continue
+			}
+			if name == "" || strings.EqualFold(r.Name, name) || strings.EqualFold(r.ID, name) {
+				matched = append(matched, r)
+			}
+		}
+		return matched
+	}
+
+	var matched []Resource
+	for _, r := range resources {
+		if strings.EqualFold(string(r.Type), query) || strings.EqualFold(r.Name, query) || strings.EqualFold(r.ID, query) {
+			matched = append(matched, r)
+		}
+	}
+	return matched
+}
+
+// hasResourceType は resources に指定した種類のリソースが含まれるかを返す
+//
+// 種類として解釈できない場合は、`:` を含む名前や ID としてクエリ全体で照合できるようにする
+func hasResourceType(resources []Resource, resourceType string) bool {
+	for _, r := range resources {
+		if strings.EqualFold(string(r.Type), resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterResources は種類でリソースを絞り込む
+//
+// include が空でなければそのいずれかの種類のリソースのみを残し、exclude のいずれかの種類のリソースは除く
+func FilterResources(resources []Resource, include, exclude []ResourceType) []Resource {
+	var filtered []Resource
+	for _, r := range resources {
+		if len(include) > 0 && !slices.Contains(include, r.Type) {
+			continue
+		}
+		if slices.Contains(exclude, r.Type) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
+// FuzzyMatch は query の各文字が text に順に現れるかを大文字小文字を区別せずに調べる
+//
+// 一致した場合は text 中で一致した文字の位置 (rune 単位) を返す
+func FuzzyMatch(text, query string) ([]int, bool) {
+	queryRunes := []rune(query)
+	if len(queryRunes) == 0 {
+		return nil, true
+	}
+
+	var positions []int
+	for i, r := range []rune(text) {
+		if unicode.ToLower(r) != unicode.ToLower(queryRunes[len(positions)]) {
+			continue
+		}
+		positions = append(positions, i)
+		if len(positions) == len(queryRunes) {
+			return positions, true
+		}
+	}
+	return nil, false
+}
+
+// MatchesFuzzy はリソースの表示名・種類・バインディング名・ID のいずれかがクエリにあいまい一致するかを返す
+//
+// スペースで区切られたクエリはそれぞれがいずれかに一致する必要がある
+func (r Resource) MatchesFuzzy(query string) bool {
+	targets := []string{r.Display(), string(r.Type), r.Name, r.ID}
+	for term := range strings.FieldsSeq(query) {
+		matched := false
+		for _, target := range targets {
+			if _, ok := FuzzyMatch(target, term); ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
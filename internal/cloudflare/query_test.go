@@ -0,0 +1,246 @@
+package cloudflare
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatchResources(t *testing.T) {
+	t.Parallel()
+
+	resources := []Resource{
+		{Type: ResourceTypeWorker, Name: "my-worker", ID: "my-worker"},
+		{Type: ResourceTypeKV, Name: "CACHE", ID: "kv-cache-id"},
+		{Type: ResourceTypeKV, Name: "SESSIONS", ID: "kv-sessions-id"},
+		{Type: ResourceTypeD1, Name: "DB", ID: "d1-id"},
+		{Type: ResourceTypeR2, Name: "CACHE", ID: "cache-bucket"},
+		{Type: ResourceTypeZone, Name: "example.com", ID: "example.com:8080"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "種類で照合する",
+			query: "d1",
+			want:  []string{"DB"},
+		},
+		{
+			name:  "バインディング名で照合する",
+			query: "DB",
+			want:  []string{"DB"},
+		},
+		{
+			name:  "大文字小文字を区別しない",
+			query: "sessions",
+			want:  []string{"SESSIONS"},
+		},
+		{
+			name:  "ID で照合する",
+			query: "kv-cache-id",
+			want:  []string{"CACHE"},
+		},
+		{
+			name:  "同じ種類のリソースが複数ある",
+			query: "kv",
+			want:  []string{"CACHE", "SESSIONS"},
+		},
+		{
+			name:  "同じバインディング名のリソースが複数ある",
+			query: "CACHE",
+			want:  []string{"CACHE", "CACHE"},
+		},
+		{
+			name:  "種類と名前で絞り込む",
+			query: "kv:CACHE",
+			want:  []string{"CACHE"},
+		},
+		{
+			name:  "種類と ID で絞り込む",
+			query: "r2:cache-bucket",
+			want:  []string{"CACHE"},
+		},
+		{
+			name:  "名前が空の場合はその種類のすべてのリソース",
+			query: "kv:",
+			want:  []string{"CACHE", "SESSIONS"},
+		},
+		{
+			name:  "種類として解釈できない場合はクエリ全体で照合する",
+			query: "example.com:8080",
+			want:  []string{"example.com"},
+		},
+		{
+			name:  "一致しない",
+			query: "missing",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := MatchResources(resources, tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("MatchResources() = %+v, want names %v", got, tt.want)
+			}
+			for i, r := range got {
+				if r.Name != tt.want[i] {
+					t.Errorf("MatchResources()[%d].Name = %q, want %q", i, r.Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFilterResources(t *testing.T) {
+	t.Parallel()
+
+	resources := []Resource{
+		{Type: ResourceTypeWorker, Name: "my-worker"},
+		{Type: ResourceTypeObservability, Name: "my-worker"},
+		{Type: ResourceTypeCronTriggers, Name: "my-worker"},
+		{Type: ResourceTypeKV, Name: "CACHE"},
+		{Type: ResourceTypeD1, Name: "DB"},
+	}
+
+	tests := []struct {
+		name    string
+		include []ResourceType
+		exclude []ResourceType
+		want    []ResourceType
+	}{
+		{
+			name: "指定がなければすべて",
+			want: []ResourceType{ResourceTypeWorker, ResourceTypeObservability, ResourceTypeCronTriggers, ResourceTypeKV, ResourceTypeD1},
+		},
+		{
+			name:    "指定した種類のみ",
+			include: []ResourceType{ResourceTypeKV, ResourceTypeD1},
+			want:    []ResourceType{ResourceTypeKV, ResourceTypeD1},
+		},
+		{
+			name:    "指定した種類を除く",
+			exclude: []ResourceType{ResourceTypeObservability, ResourceTypeCronTriggers},
+			want:    []ResourceType{ResourceTypeWorker, ResourceTypeKV, ResourceTypeD1},
+		},
+		{
+			name:    "除外が優先される",
+			include: []ResourceType{ResourceTypeWorker, ResourceTypeKV},
+			exclude: []ResourceType{ResourceTypeKV},
+			want:    []ResourceType{ResourceTypeWorker},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FilterResources(resources, tt.include, tt.exclude)
+			if len(got) != len(tt.want) {
+				t.Fatalf("FilterResources() = %+v, want types %v", got, tt.want)
+			}
+			for i, r := range got {
+				if r.Type != tt.want[i] {
+					t.Errorf("FilterResources()[%d].Type = %q, want %q", i, r.Type, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFuzzyMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		text          string
+		query         string
+		wantPositions []int
+		wantOK        bool
+	}{
+		{
+			name:          "連続した文字に一致する",
+			text:          "KV: CACHE",
+			query:         "cache",
+			wantPositions: []int{4, 5, 6, 7, 8},
+			wantOK:        true,
+		},
+		{
+			name:          "離れた文字に順に一致する",
+			text:          "D1: database",
+			query:         "dtb",
+			wantPositions: []int{0, 6, 8},
+			wantOK:        true,
+		},
+		{
+			name:          "マルチバイト文字の位置は rune 単位",
+			text:          "Service: AUTH → auth-worker",
+			query:         "→w",
+			wantPositions: []int{14, 21},
+			wantOK:        true,
+		},
+		{
+			name:   "順序が異なる場合は一致しない",
+			text:   "KV: CACHE",
+			query:  "ek",
+			wantOK: false,
+		},
+		{
+			name:   "空のクエリはすべてに一致する",
+			text:   "KV: CACHE",
+			query:  "",
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			positions, ok := FuzzyMatch(tt.text, tt.query)
+			if ok != tt.wantOK {
+				t.Fatalf("FuzzyMatch() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !slices.Equal(positions, tt.wantPositions) {
+				t.Errorf("FuzzyMatch() positions = %v, want %v", positions, tt.wantPositions)
+			}
+		})
+	}
+}
+
+func TestResource_MatchesFuzzy(t *testing.T) {
+	t.Parallel()
+
+	resource := Resource{
+		Type:        ResourceTypeD1,
+		Name:        "DB",
+		ID:          "0f1e2d3c-d1-id",
+		Description: "D1: main-database (0f1e2d3c-d1-id)",
+	}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{query: "maindb", want: true},
+		{query: "d1", want: true},
+		{query: "0f1e", want: true},
+		{query: "d1 main", want: true},
+		{query: "kv", want: false},
+		{query: "d1 kv", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			t.Parallel()
+
+			if got := resource.MatchesFuzzy(tt.query); got != tt.want {
+				t.Errorf("Resource.MatchesFuzzy(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,35 +1,116 @@
 package cloudflare
 
+import (
+	"fmt"
+	"strings"
+)
+
 type ResourceType string
 
 const (
-	ResourceTypeWorker           ResourceType = "worker"
-	ResourceTypeObservability    ResourceType = "observability"
-	ResourceTypeCronTriggers     ResourceType = "cron_triggers"
-	ResourceTypeQueue            ResourceType = "queue"
-	ResourceTypeWorkflow         ResourceType = "workflow"
-	ResourceTypeBrowserRendering ResourceType = "browser_rendering"
-	ResourceTypeVPC              ResourceType = "vpc"
-	ResourceTypeR2               ResourceType = "r2"
-	ResourceTypeKV               ResourceType = "kv"
-	ResourceTypeD1               ResourceType = "d1"
-	ResourceTypePipeline         ResourceType = "pipeline"
-	ResourceTypeVectorize        ResourceType = "vectorize"
-	ResourceTypeSecretsStore     ResourceType = "secrets_store"
-	ResourceTypeImages           ResourceType = "images"
+	ResourceTypeWorker            ResourceType = "worker"
+	ResourceTypePages             ResourceType = "pages"
+	ResourceTypeObservability     ResourceType = "observability"
+	ResourceTypeCronTriggers      ResourceType = "cron_triggers"
+	ResourceTypeTailConsumer      ResourceType = "tail_consumer"
+	ResourceTypeLogpush           ResourceType = "logpush"
+	ResourceTypeZone              ResourceType = "zone"
+	ResourceTypeZoneDNS           ResourceType = "zone_dns"
+	ResourceTypeWorkersRoutes     ResourceType = "workers_routes"
+	ResourceTypeDurableObject     ResourceType = "durable_object"
+	ResourceTypeService           ResourceType = "service"
+	ResourceTypeQueue             ResourceType = "queue"
+	ResourceTypeWorkflow          ResourceType = "workflow"
+	ResourceTypeBrowserRendering  ResourceType = "browser_rendering"
+	ResourceTypeVPC               ResourceType = "vpc"
+	ResourceTypeR2                ResourceType = "r2"
+	ResourceTypeKV                ResourceType = "kv"
+	ResourceTypeD1                ResourceType = "d1"
+	ResourceTypeHyperdrive        ResourceType = "hyperdrive"
+	ResourceTypePipeline          ResourceType = "pipeline"
+	ResourceTypeVectorize         ResourceType = "vectorize"
+	ResourceTypeWorkersAI         ResourceType = "workers_ai"
+	ResourceTypeAIGateway         ResourceType = "ai_gateway"
+	ResourceTypeAnalyticsEngine   ResourceType = "analytics_engine"
+	ResourceTypeSecretsStore      ResourceType = "secrets_store"
+	ResourceTypeImages            ResourceType = "images"
+	ResourceTypeSendEmail         ResourceType = "send_email"
+	ResourceTypeMTLSCertificate   ResourceType = "mtls_certificate"
+	ResourceTypeRateLimit         ResourceType = "rate_limit"
+	ResourceTypeContainer         ResourceType = "container"
+	ResourceTypeDispatchNamespace ResourceType = "dispatch_namespace"
 )
 
+// AllResourceTypes は cf-open が扱うすべてのリソースの種類
+var AllResourceTypes = []ResourceType{
+	ResourceTypeWorker,
+	ResourceTypePages,
+	ResourceTypeObservability,
+	ResourceTypeCronTriggers,
+	ResourceTypeTailConsumer,
+	ResourceTypeLogpush,
+	ResourceTypeZone,
+	ResourceTypeZoneDNS,
+	ResourceTypeWorkersRoutes,
+	ResourceTypeDurableObject,
+	ResourceTypeService,
+	ResourceTypeQueue,
+	ResourceTypeWorkflow,
+	ResourceTypeBrowserRendering,
+	ResourceTypeVPC,
+	ResourceTypeR2,
+	ResourceTypeKV,
+	ResourceTypeD1,
+	ResourceTypeHyperdrive,
+	ResourceTypePipeline,
+	ResourceTypeVectorize,
+	ResourceTypeWorkersAI,
+	ResourceTypeAIGateway,
+	ResourceTypeAnalyticsEngine,
+	ResourceTypeSecretsStore,
+	ResourceTypeImages,
+	ResourceTypeSendEmail,
+	ResourceTypeMTLSCertificate,
+	ResourceTypeRateLimit,
+	ResourceTypeContainer,
+	ResourceTypeDispatchNamespace,
+}
+
+// ParseResourceType は文字列を ResourceType に変換する
+//
+// 未知の種類の場合は指定できる種類を列挙したエラーを返す
+func ParseResourceType(s string) (ResourceType, error) {
+	for _, t := range AllResourceTypes {
+		if strings.EqualFold(string(t), s) {
+			return t, nil
+		}
+	}
+
+	names := make([]string, len(AllResourceTypes))
+	for i, t := range AllResourceTypes {
+		names[i] = string(t)
+	}
+	return "", fmt.Errorf("unknown resource type %q (available: %s)", s, strings.Join(names, ", "))
+}
+
 type Resource struct {
 	Type        ResourceType
 	Name        string
 	ID          string
 	Description string
 	URL         string
+	// Project はワークスペースモードでリソースが属するプロジェクト名
+	Project string
 }
 
 func (r Resource) Display() string {
+	label := r.Name
 	if r.Description != "" {
-		return r.Description
+		label = r.Description
+	}
+
+	if r.Project != "" {
+		return fmt.Sprintf("[%s] %s", r.Project, label)
 	}
-	return r.Name
+	return label
 }
@@ -26,6 +26,15 @@ func TestResource_Display(t *testing.T) {
 			},
 			want: "my-worker",
 		},
+		{
+			name: "プロジェクトがある場合はプロジェクト名を前に付ける",
+			resource: Resource{
+				Name:        "my-worker",
+				Description: "Worker: my-worker",
+				Project:     "apps/api",
+			},
+			want: "[apps/api] Worker: my-worker",
+		},
 	}
 
 	for _, tt := range tests {
@@ -39,3 +48,33 @@ func TestResource_Display(t *testing.T) {
 		})
 	}
 }
+
+func TestParseResourceType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    ResourceType
+		wantErr bool
+	}{
+		{input: "kv", want: ResourceTypeKV},
+		{input: "D1", want: ResourceTypeD1},
+		{input: "cron_triggers", want: ResourceTypeCronTriggers},
+		{input: "unknown", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseResourceType(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResourceType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseResourceType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package cloudflare
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mst-mkt/cf-open/internal/config"
+)
+
+// WorkerPage は Worker のダッシュボード内のページを表す
+type WorkerPage string
+
+const (
+	WorkerPageDeployments WorkerPage = "deployments"
+	WorkerPageVersions    WorkerPage = "versions"
+	WorkerPageVariables   WorkerPage = "variables"
+	WorkerPageLogs        WorkerPage = "logs"
+	WorkerPageMetrics     WorkerPage = "metrics"
+	WorkerPageDomains     WorkerPage = "domains"
+)
+
+// WorkerPages は `cf-open worker-page` で開けるページの一覧
+var WorkerPages = []WorkerPage{
+	WorkerPageDeployments,
+	WorkerPageVersions,
+	WorkerPageVariables,
+	WorkerPageLogs,
+	WorkerPageMetrics,
+	WorkerPageDomains,
+}
+
+// Label はページの表示名を返す
+func (p WorkerPage) Label() string {
+	switch p {
+	case WorkerPageDeployments:
+		return "Deployments"
+	case WorkerPageVersions:
+		return "Versions"
+	case WorkerPageVariables:
+		return "Variables and Secrets"
+	case WorkerPageLogs:
+		return "Logs"
+	case WorkerPageMetrics:
+		return "Metrics"
+	case WorkerPageDomains:
+		return "Domains & Routes"
+	}
+	return string(p)
+}
+
+// path は Worker のページからの相対パスを返す
+func (p WorkerPage) path() string {
+	switch p {
+	case WorkerPageVariables:
+		return "settings#variables"
+	case WorkerPageLogs:
+		return "observability/logs"
+	case WorkerPageDomains:
+		return "settings#domains-and-routes"
+	}
+	return string(p)
+}
+
+// ParseWorkerPage は文字列を WorkerPage に変換する
+func ParseWorkerPage(s string) (WorkerPage, error) {
+	for _, page := range WorkerPages {
+		if strings.EqualFold(string(page), s) {
+			return page, nil
+		}
+	}
+
+	names := make([]string, len(WorkerPages))
+	for i, page := range WorkerPages {
+		names[i] = string(page)
+	}
+	return "", fmt.Errorf("unknown worker page %q (available: %s)", s, strings.Join(names, ", "))
+}
+
+// GetWorkerPageResources は設定の Worker のサブページを pages の順に返す
+//
+// Worker 名がない設定や Pages プロジェクトの設定ではエラーを返す
+func GetWorkerPageResources(config *config.WranglerConfig, pages []WorkerPage, accountID string, hasAccount bool) ([]Resource, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("worker name is not set in wrangler config")
+	}
+	if config.IsPages() {
+		return nil, fmt.Errorf("%s is a Pages project, not a Worker", config.Name)
+	}
+
+	resources := make([]Resource, len(pages))
+	for i, page := range pages {
+		pageURL := fmt.Sprintf("%s/%s", workerPath(config.Name, ""), page.path())
+		resources[i] = Resource{
+			Type:        ResourceTypeWorker,
+			Name:        config.Name,
+			ID:          config.Name,
+			Description: fmt.Sprintf("Worker %s: %s", page.Label(), config.Name),
+			URL:         BuildDashboardURL(accountID, pageURL, hasAccount),
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,98 @@
+package cloudflare
+
+import (
+	"testing"
+
+	"github.com/mst-mkt/cf-open/internal/config"
+)
+
+func TestGetWorkerPageResources(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.WranglerConfig{Name: "my-worker"}
+
+	want := []Resource{
+		{Description: "Worker Deployments: my-worker", URL: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/deployments"},
+		{Description: "Worker Versions: my-worker", URL: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/versions"},
+		{Description: "Worker Variables and Secrets: my-worker", URL: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/settings#variables"},
+		{Description: "Worker Logs: my-worker", URL: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/observability/logs"},
+		{Description: "Worker Metrics: my-worker", URL: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/metrics"},
+		{Description: "Worker Domains & Routes: my-worker", URL: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production/settings#domains-and-routes"},
+	}
+
+	resources, err := GetWorkerPageResources(cfg, WorkerPages, "acc", true)
+	if err != nil {
+		t.Fatalf("GetWorkerPageResources() error = %v", err)
+	}
+	if len(resources) != len(want) {
+		t.Fatalf("リソース数 = %d, want %d", len(resources), len(want))
+	}
+	for i, r := range resources {
+		if r.Type != ResourceTypeWorker {
+			t.Errorf("resources[%d].Type = %q, want %q", i, r.Type, ResourceTypeWorker)
+		}
+		if r.Description != want[i].Description {
+			t.Errorf("resources[%d].Description = %q, want %q", i, r.Description, want[i].Description)
+		}
+		if r.URL != want[i].URL {
+			t.Errorf("resources[%d].URL = %q, want %q", i, r.URL, want[i].URL)
+		}
+	}
+}
+
+func TestGetWorkerPageResources_Error(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config *config.WranglerConfig
+	}{
+		{
+			name:   "Worker 名がない",
+			config: &config.WranglerConfig{},
+		},
+		{
+			name:   "Pages プロジェクト",
+			config: &config.WranglerConfig{Name: "my-site", PagesBuildOutputDir: "./dist"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := GetWorkerPageResources(tt.config, WorkerPages, "acc", true); err == nil {
+				t.Error("GetWorkerPageResources() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseWorkerPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    WorkerPage
+		wantErr bool
+	}{
+		{input: "deployments", want: WorkerPageDeployments},
+		{input: "Logs", want: WorkerPageLogs},
+		{input: "domains", want: WorkerPageDomains},
+		{input: "unknown", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseWorkerPage(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWorkerPage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseWorkerPage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,10 +3,13 @@ package config
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
 )
 
 const defaultWranglerCachePath = "node_modules/.cache/wrangler/wrangler-account.json"
 
+const accountIDEnvKey = "CLOUDFLARE_ACCOUNT_ID"
+
 type AccountInfo struct {
 	Account struct {
 		ID   string `json:"id"`
@@ -14,24 +17,48 @@ type AccountInfo struct {
 	} `json:"account"`
 }
 
+// AccountSource は Account ID をどこから取得したかを表す
+type AccountSource string
+
+const (
+	AccountSourceNone   AccountSource = ""
+	AccountSourceFlag   AccountSource = "--account-id flag"
+	AccountSourceConfig AccountSource = "account_id in wrangler config"
+	AccountSourceEnv    AccountSource = accountIDEnvKey + " environment variable"
+	AccountSourceCache  AccountSource = "wrangler account cache"
+)
+
 func GetAccountID(config *WranglerConfig, flagAccountID string) (string, bool) {
+	accountID, source := ResolveAccountID(config, flagAccountID)
+	return accountID, source != AccountSourceNone
+}
+
+// ResolveAccountID は Wrangler と同じ優先順位で Account ID を解決し、その取得元とともに返す
+//
+// 優先順位: `--account-id` フラグ > 設定の `account_id` > `CLOUDFLARE_ACCOUNT_ID` > `wrangler-account.json`
+func ResolveAccountID(config *WranglerConfig, flagAccountID string) (string, AccountSource) {
 	if flagAccountID != "" {
-		return flagAccountID, true
+		return flagAccountID, AccountSourceFlag
 	}
 
 	if config.AccountID != "" {
-		return config.AccountID, true
+		return config.AccountID, AccountSourceConfig
+	}
+
+	if accountID := os.Getenv(accountIDEnvKey); accountID != "" {
+		return accountID, AccountSourceEnv
 	}
 
-	if accountID := getAccountFromCache(); accountID != "" {
-		return accountID, true
+	if accountID := getAccountFromCache(config.ProjectDir()); accountID != "" {
+		return accountID, AccountSourceCache
 	}
 
-	return "", false
+	return "", AccountSourceNone
 }
 
-func getAccountFromCache() string {
-	cacheFile := defaultWranglerCachePath
+// getAccountFromCache はプロジェクトのルートにある Wrangler のキャッシュから Account ID を読み込む
+func getAccountFromCache(projectDir string) string {
+	cacheFile := filepath.Join(projectDir, defaultWranglerCachePath)
 
 	data, err := os.ReadFile(cacheFile)
 	if err != nil {
@@ -1,9 +1,13 @@
 package config
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
 
 func TestGetAccountID(t *testing.T) {
-	t.Parallel()
+	t.Setenv(accountIDEnvKey, "")
 
 	tests := []struct {
 		name          string
@@ -43,8 +47,6 @@ func TestGetAccountID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
 			gotID, gotHas := GetAccountID(tt.config, tt.flagAccountID)
 			if gotID != tt.wantID {
 				t.Errorf("GetAccountID() id = %q, want %q", gotID, tt.wantID)
@@ -55,3 +57,80 @@ func TestGetAccountID(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveAccountID(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        *WranglerConfig
+		flagAccountID string
+		envAccountID  string
+		wantID        string
+		wantSource    AccountSource
+	}{
+		{
+			name:          "フラグが環境変数と設定より優先される",
+			config:        &WranglerConfig{AccountID: "config-account"},
+			flagAccountID: "flag-account",
+			envAccountID:  "env-account",
+			wantID:        "flag-account",
+			wantSource:    AccountSourceFlag,
+		},
+		{
+			name:         "設定の account_id が環境変数より優先される",
+			config:       &WranglerConfig{AccountID: "config-account"},
+			envAccountID: "env-account",
+			wantID:       "config-account",
+			wantSource:   AccountSourceConfig,
+		},
+		{
+			name:         "設定に account_id がない場合は `CLOUDFLARE_ACCOUNT_ID` を使う",
+			config:       &WranglerConfig{},
+			envAccountID: "env-account",
+			wantID:       "env-account",
+			wantSource:   AccountSourceEnv,
+		},
+		{
+			name:       "どこにもない場合",
+			config:     &WranglerConfig{},
+			wantID:     "",
+			wantSource: AccountSourceNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(accountIDEnvKey, tt.envAccountID)
+
+			gotID, gotSource := ResolveAccountID(tt.config, tt.flagAccountID)
+			if gotID != tt.wantID {
+				t.Errorf("ResolveAccountID() id = %q, want %q", gotID, tt.wantID)
+			}
+			if gotSource != tt.wantSource {
+				t.Errorf("ResolveAccountID() source = %q, want %q", gotSource, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestResolveAccountID_CacheRelativeToProjectDir(t *testing.T) {
+	t.Setenv(accountIDEnvKey, "")
+
+	projectDir := t.TempDir()
+	cachePath := filepath.Join(projectDir, defaultWranglerCachePath)
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0o755); err != nil {
+		t.Fatalf("ディレクトリの作成に失敗: %v", err)
+	}
+	if err := os.WriteFile(cachePath, []byte(`{"account": {"id": "cached-account", "name": "Cached"}}`), 0o644); err != nil {
+		t.Fatalf("キャッシュファイルの書き込みに失敗: %v", err)
+	}
+
+	cfg := &WranglerConfig{ConfigPath: filepath.Join(projectDir, "wrangler.jsonc")}
+
+	gotID, gotSource := ResolveAccountID(cfg, "")
+	if gotID != "cached-account" {
+		t.Errorf("ResolveAccountID() id = %q, want %q", gotID, "cached-account")
+	}
+	if gotSource != AccountSourceCache {
+		t.Errorf("ResolveAccountID() source = %q, want %q", gotSource, AccountSourceCache)
+	}
+}
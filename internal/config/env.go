@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const envNameEnvKey = "CLOUDFLARE_ENV"
+
+// EnvSource は環境名をどこから取得したかを表す
+type EnvSource string
+
+const (
+	EnvSourceNone EnvSource = ""
+	EnvSourceFlag EnvSource = "--env flag"
+	EnvSourceEnv  EnvSource = envNameEnvKey + " environment variable"
+)
+
+// ResolveEnvName は Wrangler と同じく `--env` フラグ、`CLOUDFLARE_ENV` の順に環境名を解決する
+func ResolveEnvName(flagEnv string) (string, EnvSource) {
+	if flagEnv != "" {
+		return flagEnv, EnvSourceFlag
+	}
+
+	if envName := os.Getenv(envNameEnvKey); envName != "" {
+		return envName, EnvSourceEnv
+	}
+
+	return "", EnvSourceNone
+}
+
+// ApplyEnv は `env.<name>` の設定をトップレベルの設定に適用した WranglerConfig を返す
+//
+// Wrangler と同様に、継承可能なキー (name, account_id など) は環境側で未指定ならトップレベルの値を引き継ぎ、
+// 継承不可能なキー (vars や各種バインディング) は環境側で指定されたものだけを使う
+func ApplyEnv(config *WranglerConfig, envName string) (*WranglerConfig, error) {
+	if envName == "" {
+		return config, nil
+	}
+
+	// Wrangler と同様に、ビルドツールが生成した設定には環境を指定できない (Pages を除く)
+	if config.IsRedirected() && !config.IsPages() {
+		return nil, fmt.Errorf("environment %q cannot be used with the redirected config %s, set the environment in your build tool instead", envName, config.ConfigPath)
+	}
+
+	env, ok := config.Env[envName]
+	if !ok || env == nil {
+		// Wrangler と同様に、Pages プロジェクトで環境が定義されていない場合はトップレベルの設定を使う
+		if config.IsPages() {
+			return config, nil
+		}
+		if len(config.Env) > 0 {
+			return nil, fmt.Errorf("environment %q not found in wrangler config (available: %s)", envName, formatEnvNames(config))
+		}
+		// Wrangler と同様に、環境が 1 つも定義されていない場合は空の環境として扱う
+		// 名前は <name>-<env> になり、継承不可能なバインディングは引き継がない
+		env = &WranglerConfig{}
+	}
+
+	resolved := *env
+	resolved.Env = nil
+	resolved.ConfigPath = config.ConfigPath
+	resolved.DeployConfigPath = config.DeployConfigPath
+
+	// Pages プロジェクトの環境 (production / preview) は同じプロジェクトの一部なので名前に環境名を付けない
+	if config.IsPages() {
+		resolved.PagesBuildOutputDir = config.PagesBuildOutputDir
+		if resolved.Name == "" {
+			resolved.Name = config.Name
+		}
+	}
+	if resolved.Name == "" && config.Name != "" {
+		resolved.Name = fmt.Sprintf("%s-%s", config.Name, envName)
+	}
+	if resolved.AccountID == "" {
+		resolved.AccountID = config.AccountID
+	}
+	if resolved.CompatibilityDate == "" {
+		resolved.CompatibilityDate = config.CompatibilityDate
+	}
+	if resolved.Observability == nil {
+		resolved.Observability = config.Observability
+	}
+	if resolved.Triggers == nil {
+		resolved.Triggers = config.Triggers
+	}
+	if resolved.Route == nil && resolved.Routes == nil {
+		resolved.Route = config.Route
+		resolved.Routes = config.Routes
+	}
+	if resolved.Logpush == nil {
+		resolved.Logpush = config.Logpush
+	}
+	if resolved.Assets == nil {
+		resolved.Assets = config.Assets
+	}
+	if resolved.Migrations == nil {
+		resolved.Migrations = config.Migrations
+	}
+
+	return &resolved, nil
+}
+
+// IsUndefinedEnv は環境が 1 つも定義されていない Worker の設定に環境名が指定されたかどうかを返す
+func IsUndefinedEnv(config *WranglerConfig, envName string) bool {
+	return envName != "" && len(config.Env) == 0 && !config.IsPages()
+}
+
+// EnvNames は設定に定義されている環境名をソートして返す
+func EnvNames(config *WranglerConfig) []string {
+	names := make([]string, 0, len(config.Env))
+	for name := range config.Env {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func formatEnvNames(config *WranglerConfig) string {
+	names := EnvNames(config)
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
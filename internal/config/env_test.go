@@ -0,0 +1,470 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestResolveEnvName(t *testing.T) {
+	tests := []struct {
+		name       string
+		flagEnv    string
+		envValue   string
+		wantName   string
+		wantSource EnvSource
+	}{
+		{
+			name:       "フラグが `CLOUDFLARE_ENV` より優先される",
+			flagEnv:    "staging",
+			envValue:   "production",
+			wantName:   "staging",
+			wantSource: EnvSourceFlag,
+		},
+		{
+			name:       "フラグがない場合は `CLOUDFLARE_ENV` を使う",
+			envValue:   "production",
+			wantName:   "production",
+			wantSource: EnvSourceEnv,
+		},
+		{
+			name:       "どちらもない場合",
+			wantName:   "",
+			wantSource: EnvSourceNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envNameEnvKey, tt.envValue)
+
+			gotName, gotSource := ResolveEnvName(tt.flagEnv)
+			if gotName != tt.wantName {
+				t.Errorf("ResolveEnvName() name = %q, want %q", gotName, tt.wantName)
+			}
+			if gotSource != tt.wantSource {
+				t.Errorf("ResolveEnvName() source = %q, want %q", gotSource, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	t.Parallel()
+
+	base := &WranglerConfig{
+		Name:              "my-worker",
+		AccountID:         "account-123",
+		CompatibilityDate: "2024-01-01",
+		Observability:     &ObservabilityConfig{Enabled: true},
+		Triggers:          &TriggersConfig{Crons: []string{"0 * * * *"}},
+		Routes:            []Route{{Pattern: "example.com/*", ZoneName: "example.com"}},
+		KVNamespaces:      []KVNamespace{{Binding: "KV", ID: "prod-kv"}},
+		D1Databases:       []D1Database{{Binding: "DB", DatabaseName: "prod-db", DatabaseID: "prod-d1"}},
+		Env: map[string]*WranglerConfig{
+			"staging": {
+				KVNamespaces: []KVNamespace{{Binding: "KV", ID: "staging-kv"}},
+				D1Databases:  []D1Database{{Binding: "DB", DatabaseName: "staging-db", DatabaseID: "staging-d1"}},
+			},
+			"custom": {
+				Name:      "custom-worker",
+				AccountID: "account-456",
+				Triggers:  &TriggersConfig{Crons: []string{}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		envName  string
+		validate func(t *testing.T, cfg *WranglerConfig)
+		wantErr  bool
+	}{
+		{
+			name:    "環境名が空の場合はそのまま返す",
+			envName: "",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg != base {
+					t.Error("ApplyEnv() should return the given config when envName is empty")
+				}
+			},
+		},
+		{
+			name:    "name は <name>-<env> になる",
+			envName: "staging",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.Name != "my-worker-staging" {
+					t.Errorf("Name = %q, want %q", cfg.Name, "my-worker-staging")
+				}
+			},
+		},
+		{
+			name:    "継承可能なキーはトップレベルから引き継ぐ",
+			envName: "staging",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.AccountID != "account-123" {
+					t.Errorf("AccountID = %q, want %q", cfg.AccountID, "account-123")
+				}
+				if cfg.CompatibilityDate != "2024-01-01" {
+					t.Errorf("CompatibilityDate = %q, want %q", cfg.CompatibilityDate, "2024-01-01")
+				}
+				if cfg.Observability == nil || !cfg.Observability.Enabled {
+					t.Error("Observability should be inherited from top-level config")
+				}
+				if cfg.Triggers == nil || len(cfg.Triggers.Crons) != 1 {
+					t.Error("Triggers should be inherited from top-level config")
+				}
+				if len(cfg.Routes) != 1 {
+					t.Error("Routes should be inherited from top-level config")
+				}
+			},
+		},
+		{
+			name:    "継承不可能なキーは環境の値のみを使う",
+			envName: "staging",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.KVNamespaces) != 1 || cfg.KVNamespaces[0].ID != "staging-kv" {
+					t.Errorf("KVNamespaces = %+v, want staging-kv only", cfg.KVNamespaces)
+				}
+				if len(cfg.D1Databases) != 1 || cfg.D1Databases[0].DatabaseID != "staging-d1" {
+					t.Errorf("D1Databases = %+v, want staging-d1 only", cfg.D1Databases)
+				}
+			},
+		},
+		{
+			name:    "環境で指定された値はトップレベルより優先される",
+			envName: "custom",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.Name != "custom-worker" {
+					t.Errorf("Name = %q, want %q", cfg.Name, "custom-worker")
+				}
+				if cfg.AccountID != "account-456" {
+					t.Errorf("AccountID = %q, want %q", cfg.AccountID, "account-456")
+				}
+				if cfg.Triggers == nil || len(cfg.Triggers.Crons) != 0 {
+					t.Error("Triggers should be overridden by environment config")
+				}
+				if len(cfg.KVNamespaces) != 0 {
+					t.Errorf("len(KVNamespaces) = %d, want 0", len(cfg.KVNamespaces))
+				}
+			},
+		},
+		{
+			name:    "存在しない環境",
+			envName: "unknown",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ApplyEnv(base, tt.envName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyEnv() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, got)
+			}
+		})
+	}
+}
+
+func TestApplyEnv_NoEnvironments(t *testing.T) {
+	t.Parallel()
+
+	// Wrangler と同様に、環境を定義していない設定では空の環境として <name>-<env> を使う
+	base := &WranglerConfig{
+		Name:         "my-worker",
+		AccountID:    "account-123",
+		KVNamespaces: []KVNamespace{{Binding: "KV", ID: "kv-id"}},
+	}
+
+	if !IsUndefinedEnv(base, "staging") {
+		t.Error("IsUndefinedEnv() = false, want true when no environments are defined")
+	}
+
+	got, err := ApplyEnv(base, "staging")
+	if err != nil {
+		t.Fatalf("ApplyEnv() error = %v", err)
+	}
+	if got.Name != "my-worker-staging" {
+		t.Errorf("Name = %q, want %q", got.Name, "my-worker-staging")
+	}
+	if got.AccountID != "account-123" {
+		t.Errorf("AccountID = %q, want %q", got.AccountID, "account-123")
+	}
+	if len(got.KVNamespaces) != 0 {
+		t.Errorf("KVNamespaces = %+v, want none", got.KVNamespaces)
+	}
+}
+
+func TestApplyEnv_Redirected(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  *WranglerConfig
+		wantErr bool
+	}{
+		{
+			name: "Worker の場合はエラー",
+			config: &WranglerConfig{
+				Name:             "my-worker",
+				ConfigPath:       "/project/dist/wrangler.json",
+				DeployConfigPath: "/project/.wrangler/deploy/config.json",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Pages の場合はトップレベルの設定を使う",
+			config: &WranglerConfig{
+				Name:                "site",
+				PagesBuildOutputDir: "./dist",
+				ConfigPath:          "/project/dist/wrangler.json",
+				DeployConfigPath:    "/project/.wrangler/deploy/config.json",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ApplyEnv(tt.config, "staging")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyEnv() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.config {
+				t.Error("ApplyEnv() should return the given config for a redirected Pages config")
+			}
+		})
+	}
+}
+
+func TestApplyEnv_InheritanceRules(t *testing.T) {
+	t.Parallel()
+
+	logpush := true
+	logpushOff := false
+
+	base := &WranglerConfig{
+		Name:          "my-worker",
+		Route:         &Route{Pattern: "example.com/*", ZoneName: "example.com"},
+		TailConsumers: []TailConsumer{{Service: "tail-worker"}},
+		Logpush:       &logpush,
+		Migrations:    []Migration{{Tag: "v1", NewSqliteClasses: []string{"Counter"}}},
+		Assets:        &AssetsConfig{Directory: "./public"},
+		Env: map[string]*WranglerConfig{
+			"staging": {},
+			"custom": {
+				Routes:        []Route{{Pattern: "staging.example.com/*", ZoneName: "example.com"}},
+				TailConsumers: []TailConsumer{{Service: "staging-tail-worker"}},
+				Logpush:       &logpushOff,
+				Migrations:    []Migration{{Tag: "v1", NewClasses: []string{"Counter"}}},
+				Assets:        &AssetsConfig{Directory: "./staging-public"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		envName  string
+		validate func(t *testing.T, cfg *WranglerConfig)
+	}{
+		{
+			name:    "tail_consumers は継承しない",
+			envName: "staging",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.TailConsumers) != 0 {
+					t.Errorf("TailConsumers = %+v, want none", cfg.TailConsumers)
+				}
+			},
+		},
+		{
+			name:    "logpush・migrations・assets・route は継承する",
+			envName: "staging",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.Logpush == nil || !*cfg.Logpush {
+					t.Error("Logpush should be inherited from top-level config")
+				}
+				if !cfg.IsSQLiteDurableObject("Counter") {
+					t.Error("Migrations should be inherited from top-level config")
+				}
+				if cfg.Assets == nil || cfg.Assets.Directory != "./public" {
+					t.Errorf("Assets = %+v, want ./public", cfg.Assets)
+				}
+				if routes := cfg.AllRoutes(); len(routes) != 1 || routes[0].Pattern != "example.com/*" {
+					t.Errorf("AllRoutes() = %+v, want example.com/*", routes)
+				}
+			},
+		},
+		{
+			name:    "環境で指定された値を使う",
+			envName: "custom",
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.TailConsumers) != 1 || cfg.TailConsumers[0].Service != "staging-tail-worker" {
+					t.Errorf("TailConsumers = %+v, want staging-tail-worker only", cfg.TailConsumers)
+				}
+				if cfg.Logpush == nil || *cfg.Logpush {
+					t.Error("Logpush should be overridden by environment config")
+				}
+				if cfg.IsSQLiteDurableObject("Counter") {
+					t.Error("Migrations should be overridden by environment config")
+				}
+				if cfg.Assets == nil || cfg.Assets.Directory != "./staging-public" {
+					t.Errorf("Assets = %+v, want ./staging-public", cfg.Assets)
+				}
+				// routes を指定した環境は route を引き継がない
+				if routes := cfg.AllRoutes(); len(routes) != 1 || routes[0].Pattern != "staging.example.com/*" {
+					t.Errorf("AllRoutes() = %+v, want staging.example.com/* only", routes)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ApplyEnv(base, tt.envName)
+			if err != nil {
+				t.Fatalf("ApplyEnv() error = %v", err)
+			}
+			tt.validate(t, got)
+		})
+	}
+}
+
+func TestApplyEnv_Pages(t *testing.T) {
+	t.Parallel()
+
+	base := &WranglerConfig{
+		Name:                "site",
+		PagesBuildOutputDir: "./dist",
+		KVNamespaces:        []KVNamespace{{Binding: "KV", ID: "preview-kv"}},
+		Env: map[string]*WranglerConfig{
+			"production": {
+				KVNamespaces: []KVNamespace{{Binding: "KV", ID: "production-kv"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		envName string
+		wantKV  string
+	}{
+		{
+			name:    "定義された環境を使う",
+			envName: "production",
+			wantKV:  "production-kv",
+		},
+		{
+			name:    "定義されていない環境はトップレベルの設定を使う",
+			envName: "preview",
+			wantKV:  "preview-kv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ApplyEnv(base, tt.envName)
+			if err != nil {
+				t.Fatalf("ApplyEnv() error = %v", err)
+			}
+
+			if !got.IsPages() {
+				t.Error("IsPages() = false, want true for the environment of a Pages project")
+			}
+			if got.Name != "site" {
+				t.Errorf("Name = %q, want %q", got.Name, "site")
+			}
+			if len(got.KVNamespaces) != 1 || got.KVNamespaces[0].ID != tt.wantKV {
+				t.Errorf("KVNamespaces = %+v, want %s only", got.KVNamespaces, tt.wantKV)
+			}
+		})
+	}
+}
+
+func TestApplyEnv_LoadedConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+	}{
+		{
+			name:     "JSON の env ブロック",
+			filename: "wrangler.jsonc",
+			content: `{
+				"name": "my-worker",
+				"kv_namespaces": [{"binding": "KV", "id": "prod-kv"}],
+				"env": {
+					"staging": {
+						"kv_namespaces": [{"binding": "KV", "id": "staging-kv"}]
+					}
+				}
+			}`,
+		},
+		{
+			name:     "TOML の env ブロック",
+			filename: "wrangler.toml",
+			content: `
+name = "my-worker"
+
+[[kv_namespaces]]
+binding = "KV"
+id = "prod-kv"
+
+[env.staging]
+
+[[env.staging.kv_namespaces]]
+binding = "KV"
+id = "staging-kv"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			configPath := filepath.Join(t.TempDir(), tt.filename)
+			if err := os.WriteFile(configPath, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("テスト設定ファイルの書き込みに失敗: %v", err)
+			}
+
+			cfg, err := LoadWranglerConfig(configPath)
+			if err != nil {
+				t.Fatalf("LoadWranglerConfig() error = %v", err)
+			}
+
+			if got := EnvNames(cfg); !slices.Equal(got, []string{"staging"}) {
+				t.Errorf("EnvNames() = %v, want [staging]", got)
+			}
+
+			resolved, err := ApplyEnv(cfg, "staging")
+			if err != nil {
+				t.Fatalf("ApplyEnv() error = %v", err)
+			}
+			if resolved.Name != "my-worker-staging" {
+				t.Errorf("Name = %q, want %q", resolved.Name, "my-worker-staging")
+			}
+			if len(resolved.KVNamespaces) != 1 || resolved.KVNamespaces[0].ID != "staging-kv" {
+				t.Errorf("KVNamespaces = %+v, want staging-kv only", resolved.KVNamespaces)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tidwall/jsonc"
+)
+
+// deployConfigRelPath は Cloudflare Vite plugin や OpenNext などのビルドツールが書き出すリダイレクト用ファイルのパス
+const deployConfigRelPath = ".wrangler/deploy/config.json"
+
+type deployConfig struct {
+	ConfigPath string `json:"configPath"`
+}
+
+// findWranglerConfigWithRedirect は Wrangler と同様にリダイレクトを考慮して設定ファイルを探す
+//
+// `.wrangler/deploy/config.json` が見つかった場合はその `configPath` が指す設定ファイルのパスと、
+// リダイレクト用ファイルのパスを返す
+func findWranglerConfigWithRedirect(dir string) (string, string, error) {
+	userConfigPath := findWranglerConfigFrom(dir)
+
+	deployConfigPath := findUp(dir, func(dir string) string {
+		path := filepath.Join(dir, deployConfigRelPath)
+		if _, err := os.Stat(path); err != nil {
+			return ""
+		}
+		return path
+	})
+	if deployConfigPath == "" {
+		return userConfigPath, "", nil
+	}
+
+	redirectedConfigPath, err := readDeployConfig(deployConfigPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	if userConfigPath != "" && filepath.Join(filepath.Dir(userConfigPath), deployConfigRelPath) != deployConfigPath {
+		return "", "", fmt.Errorf("found both a user config file at %q and a deploy config file at %q, but they do not share the same base path", userConfigPath, deployConfigPath)
+	}
+
+	return redirectedConfigPath, deployConfigPath, nil
+}
+
+// resolveProjectRedirect は設定ファイルと同じディレクトリにリダイレクト用ファイルがあれば、
+// リダイレクト先の設定ファイルのパスとリダイレクト用ファイルのパスを返す
+//
+// ワークスペースでは各プロジェクトのディレクトリのみを調べ、親ディレクトリへは遡らない
+func resolveProjectRedirect(configPath string) (string, string, error) {
+	deployConfigPath := filepath.Join(filepath.Dir(configPath), deployConfigRelPath)
+	if _, err := os.Stat(deployConfigPath); err != nil {
+		return configPath, "", nil
+	}
+
+	redirectedConfigPath, err := readDeployConfig(deployConfigPath)
+	if err != nil {
+		return "", "", err
+	}
+	return redirectedConfigPath, deployConfigPath, nil
+}
+
+// readDeployConfig はリダイレクト用ファイルを読み込み、リダイレクト先の設定ファイルのパスを返す
+func readDeployConfig(deployConfigPath string) (string, error) {
+	data, err := os.ReadFile(deployConfigPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read deploy config file: %w", err)
+	}
+
+	var config deployConfig
+	if err := json.Unmarshal(jsonc.ToJSON(data), &config); err != nil {
+		return "", fmt.Errorf("failed to parse deploy config file at %q: %w", deployConfigPath, err)
+	}
+
+	if config.ConfigPath == "" {
+		return "", fmt.Errorf("deploy config file at %q does not have the required \"configPath\" property", deployConfigPath)
+	}
+
+	// `configPath` はリダイレクト用ファイルのディレクトリからの相対パス
+	redirectedConfigPath := config.ConfigPath
+	if !filepath.IsAbs(redirectedConfigPath) {
+		redirectedConfigPath = filepath.Join(filepath.Dir(deployConfigPath), redirectedConfigPath)
+	}
+
+	if _, err := os.Stat(redirectedConfigPath); err != nil {
+		return "", fmt.Errorf("deploy config file at %q points to %q, which does not exist", deployConfigPath, redirectedConfigPath)
+	}
+
+	return redirectedConfigPath, nil
+}
+
+// projectDirOfDeployConfig は `<project>/.wrangler/deploy/config.json` からプロジェクトのディレクトリを返す
+func projectDirOfDeployConfig(deployConfigPath string) string {
+	return filepath.Dir(filepath.Dir(filepath.Dir(deployConfigPath)))
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestFindWranglerConfigWithRedirect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		files          map[string]string
+		start          string
+		wantConfig     string
+		wantDeploy     string
+		wantErr        bool
+		wantProjectDir string
+	}{
+		{
+			name: "リダイレクト用ファイルがない場合は元の設定を使う",
+			files: map[string]string{
+				"project/wrangler.jsonc": "{}",
+			},
+			start:      "project",
+			wantConfig: "project/wrangler.jsonc",
+		},
+		{
+			name: "リダイレクト先の設定を使う",
+			files: map[string]string{
+				"project/wrangler.jsonc":               "{}",
+				"project/.wrangler/deploy/config.json": `{"configPath": "../../dist/my_worker/wrangler.json"}`,
+				"project/dist/my_worker/wrangler.json": "{}",
+				"project/src/.keep":                    "",
+			},
+			start:          "project/src",
+			wantConfig:     "project/dist/my_worker/wrangler.json",
+			wantDeploy:     "project/.wrangler/deploy/config.json",
+			wantProjectDir: "project",
+		},
+		{
+			name: "元の設定がなくてもリダイレクトできる",
+			files: map[string]string{
+				"project/.wrangler/deploy/config.json": `{"configPath": "../../dist/wrangler.json"}`,
+				"project/dist/wrangler.json":           "{}",
+			},
+			start:      "project",
+			wantConfig: "project/dist/wrangler.json",
+			wantDeploy: "project/.wrangler/deploy/config.json",
+		},
+		{
+			name: "configPath がない場合はエラー",
+			files: map[string]string{
+				"project/wrangler.jsonc":               "{}",
+				"project/.wrangler/deploy/config.json": `{}`,
+			},
+			start:   "project",
+			wantErr: true,
+		},
+		{
+			name: "リダイレクト先が存在しない場合はエラー",
+			files: map[string]string{
+				"project/wrangler.jsonc":               "{}",
+				"project/.wrangler/deploy/config.json": `{"configPath": "../../dist/wrangler.json"}`,
+			},
+			start:   "project",
+			wantErr: true,
+		},
+		{
+			name: "元の設定とリダイレクト用ファイルのディレクトリが異なる場合はエラー",
+			files: map[string]string{
+				"project/.wrangler/deploy/config.json": `{"configPath": "../../dist/wrangler.json"}`,
+				"project/dist/wrangler.json":           "{}",
+				"project/apps/api/wrangler.jsonc":      "{}",
+			},
+			start:   "project/apps/api",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			writeFiles(t, root, tt.files)
+
+			gotConfig, gotDeploy, err := findWranglerConfigWithRedirect(filepath.Join(root, tt.start))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("findWranglerConfigWithRedirect() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if want := filepath.Join(root, tt.wantConfig); gotConfig != want {
+				t.Errorf("findWranglerConfigWithRedirect() config = %q, want %q", gotConfig, want)
+			}
+
+			wantDeploy := ""
+			if tt.wantDeploy != "" {
+				wantDeploy = filepath.Join(root, tt.wantDeploy)
+			}
+			if gotDeploy != wantDeploy {
+				t.Errorf("findWranglerConfigWithRedirect() deploy = %q, want %q", gotDeploy, wantDeploy)
+			}
+
+			if tt.wantProjectDir != "" {
+				cfg := &WranglerConfig{ConfigPath: gotConfig, DeployConfigPath: gotDeploy}
+				if want := filepath.Join(root, tt.wantProjectDir); cfg.ProjectDir() != want {
+					t.Errorf("ProjectDir() = %q, want %q", cfg.ProjectDir(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Route は `route` / `routes` の要素を表す
+//
+// Wrangler と同様に `"example.com/*"` のような文字列と、
+// `{ pattern, zone_name | zone_id | custom_domain }` のようなオブジェクトの両方を受け付ける
+type Route struct {
+	Pattern      string `json:"pattern" toml:"pattern"`
+	ZoneName     string `json:"zone_name" toml:"zone_name"`
+	ZoneID       string `json:"zone_id" toml:"zone_id"`
+	CustomDomain bool   `json:"custom_domain" toml:"custom_domain"`
+}
+
+func (r *Route) UnmarshalJSON(data []byte) error {
+	var pattern string
+	if err := json.Unmarshal(data, &pattern); err == nil {
+		*r = Route{Pattern: pattern}
+		return nil
+	}
+
+	// UnmarshalJSON の再帰呼び出しを避けるため別の型を経由する
+	type route Route
+	var v route
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("invalid route: %w", err)
+	}
+	*r = Route(v)
+	return nil
+}
+
+func (r *Route) UnmarshalTOML(data any) error {
+	switch v := data.(type) {
+	case string:
+		*r = Route{Pattern: v}
+	case map[string]any:
+		pattern, _ := v["pattern"].(string)
+		zoneName, _ := v["zone_name"].(string)
+		zoneID, _ := v["zone_id"].(string)
+		customDomain, _ := v["custom_domain"].(bool)
+		*r = Route{Pattern: pattern, ZoneName: zoneName, ZoneID: zoneID, CustomDomain: customDomain}
+	default:
+		return fmt.Errorf("invalid route: %v", data)
+	}
+	return nil
+}
+
+// Host はルートのパターンからホスト名を取り出す
+//
+// `https://*.example.com/api/*` のようなパターンの場合は `example.com` を返す
+func (r Route) Host() string {
+	host := r.Pattern
+	if _, rest, ok := strings.Cut(host, "://"); ok {
+		host = rest
+	}
+	host, _, _ = strings.Cut(host, "/")
+	host, _, _ = strings.Cut(host, ":")
+	host = strings.TrimPrefix(host, "*.")
+	return strings.TrimPrefix(host, "*")
+}
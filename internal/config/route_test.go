@@ -0,0 +1,116 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestLoadWranglerConfig_Routes(t *testing.T) {
+	t.Parallel()
+
+	want := []Route{
+		{Pattern: "example.com/*"},
+		{Pattern: "api.example.com/*", ZoneName: "example.com"},
+		{Pattern: "shop.example.net/*", ZoneID: "zone-id-123"},
+		{Pattern: "app.example.org", CustomDomain: true},
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     []Route
+	}{
+		{
+			name:     "JSON のすべての形式",
+			filename: "wrangler.jsonc",
+			content: `{
+				"name": "routes-worker",
+				"routes": [
+					"example.com/*",
+					{"pattern": "api.example.com/*", "zone_name": "example.com"},
+					{"pattern": "shop.example.net/*", "zone_id": "zone-id-123"},
+					{"pattern": "app.example.org", "custom_domain": true}
+				]
+			}`,
+			want: want,
+		},
+		{
+			name:     "TOML のすべての形式",
+			filename: "wrangler.toml",
+			content: `
+name = "routes-worker"
+routes = [
+  "example.com/*",
+  { pattern = "api.example.com/*", zone_name = "example.com" },
+  { pattern = "shop.example.net/*", zone_id = "zone-id-123" },
+  { pattern = "app.example.org", custom_domain = true },
+]
+`,
+			want: want,
+		},
+		{
+			name:     "JSON の route (文字列)",
+			filename: "wrangler.json",
+			content:  `{"name": "route-worker", "route": "example.com/*"}`,
+			want:     []Route{{Pattern: "example.com/*"}},
+		},
+		{
+			name:     "TOML の route (オブジェクト)",
+			filename: "wrangler.toml",
+			content: `
+name = "route-worker"
+route = { pattern = "api.example.com/*", zone_name = "example.com" }
+`,
+			want: []Route{{Pattern: "api.example.com/*", ZoneName: "example.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			configPath := filepath.Join(t.TempDir(), tt.filename)
+			if err := os.WriteFile(configPath, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("テスト設定ファイルの書き込みに失敗: %v", err)
+			}
+
+			cfg, err := LoadWranglerConfig(configPath)
+			if err != nil {
+				t.Fatalf("LoadWranglerConfig() error = %v", err)
+			}
+
+			if got := cfg.AllRoutes(); !slices.Equal(got, tt.want) {
+				t.Errorf("AllRoutes() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoute_Host(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: "example.com/*", want: "example.com"},
+		{pattern: "*.example.com/*", want: "example.com"},
+		{pattern: "*example.com/*", want: "example.com"},
+		{pattern: "https://api.example.com/v1/*", want: "api.example.com"},
+		{pattern: "app.example.org", want: "app.example.org"},
+		{pattern: "example.com:8080/*", want: "example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+
+			if got := (Route{Pattern: tt.pattern}).Host(); got != tt.want {
+				t.Errorf("Route.Host() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// maxWorkspaceDepth はワークスペース内で設定ファイルを探すディレクトリの深さの上限
+const maxWorkspaceDepth = 5
+
+// FindWorkspaceRoot は dir から親ディレクトリへ遡ってワークスペースのルートを探す
+//
+// `pnpm-workspace.yaml` か `workspaces` を持つ `package.json` があるディレクトリ、
+// なければリポジトリのルートをワークスペースのルートとみなす。どちらも見つからない場合は dir を返す
+func FindWorkspaceRoot(dir string) string {
+	current := dir
+	for {
+		if patterns, err := readWorkspacePatterns(current); err == nil && len(patterns) > 0 {
+			return current
+		}
+
+		if isRepositoryRoot(current) {
+			return current
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// FindWorkspaceConfigs はワークスペース内のすべての Wrangler の設定ファイルを探す
+//
+// `pnpm-workspace.yaml` か `package.json` の `workspaces` があればそのパターンに一致するディレクトリのみを、
+// なければ root 以下を maxWorkspaceDepth の深さまで探索する
+func FindWorkspaceConfigs(root string) ([]string, error) {
+	patterns, err := readWorkspacePatterns(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var includes, excludes []string
+	for _, pattern := range patterns {
+		if excluded, ok := strings.CutPrefix(pattern, "!"); ok {
+			excludes = append(excludes, normalizeWorkspacePattern(excluded))
+			continue
+		}
+		includes = append(includes, normalizeWorkspacePattern(pattern))
+	}
+
+	var configPaths []string
+	err = filepath.WalkDir(root, func(dir string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// 読み込めないサブディレクトリはスキップし、ルート自体を読み込めない場合のみ失敗する
+			if dir == root {
+				return err
+			}
+			if d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel != "." {
+			if d.Name() == "node_modules" || strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			if strings.Count(rel, "/")+1 > maxWorkspaceDepth {
+				return filepath.SkipDir
+			}
+			if len(includes) > 0 && !matchesAnyWorkspacePattern(includes, rel) {
+				return nil
+			}
+			if matchesAnyWorkspacePattern(excludes, rel) {
+				return nil
+			}
+		}
+
+		if configPath := findWranglerConfigIn(dir); configPath != "" {
+			configPaths = append(configPaths, configPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search workspace: %w", err)
+	}
+
+	return excludeRedirectTargets(configPaths), nil
+}
+
+// excludeRedirectTargets はビルドツールが生成した設定 (他のプロジェクトの `.wrangler/deploy/config.json` が指す設定) を除く
+//
+// 生成された設定はリダイレクト元のプロジェクトとして読み込まれるため、別のプロジェクトとして扱わない
+func excludeRedirectTargets(configPaths []string) []string {
+	targets := make(map[string]bool)
+	for _, configPath := range configPaths {
+		deployConfigPath := filepath.Join(filepath.Dir(configPath), deployConfigRelPath)
+		if _, err := os.Stat(deployConfigPath); err != nil {
+			continue
+		}
+		// 読み込めないリダイレクト用ファイルは設定の読み込み時にエラーとして扱う
+		if target, err := readDeployConfig(deployConfigPath); err == nil {
+			targets[filepath.Clean(target)] = true
+		}
+	}
+
+	var filtered []string
+	for _, configPath := range configPaths {
+		if !targets[filepath.Clean(configPath)] {
+			filtered = append(filtered, configPath)
+		}
+	}
+	return filtered
+}
+
+// LoadWranglerConfigs は複数の設定ファイルを並行して読み込む
+//
+// 各プロジェクトのディレクトリに `.wrangler/deploy/config.json` があれば、単一のプロジェクトと同様にリダイレクト先の設定を読み込む。
+// 戻り値の順序は paths の順序と同じになる
+func LoadWranglerConfigs(paths []string) ([]*WranglerConfig, error) {
+	configs := make([]*WranglerConfig, len(paths))
+	errs := make([]error, len(paths))
+
+	var wg sync.WaitGroup
+	for i, configPath := range paths {
+		wg.Go(func() {
+			configs[i], errs[i] = loadProjectConfig(configPath)
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("%s: %w", configPath, errs[i])
+			}
+		})
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+// loadProjectConfig はワークスペース内のプロジェクトの設定を、リダイレクトを考慮して読み込む
+func loadProjectConfig(configPath string) (*WranglerConfig, error) {
+	redirectedConfigPath, deployConfigPath, err := resolveProjectRedirect(configPath)
+	if err != nil {
+		return nil, err
+	}
+	return loadWranglerConfigFile(redirectedConfigPath, deployConfigPath)
+}
+
+// readWorkspacePatterns は `pnpm-workspace.yaml` か `package.json` からワークスペースのパターンを読み込む
+func readWorkspacePatterns(root string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "pnpm-workspace.yaml"))
+	if err == nil {
+		return parsePnpmWorkspacePackages(data), nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read pnpm-workspace.yaml: %w", err)
+	}
+
+	data, err = os.ReadFile(filepath.Join(root, "package.json"))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	return parsePackageJSONWorkspaces(data)
+}
+
+// parsePnpmWorkspacePackages は `pnpm-workspace.yaml` の `packages` のリストを取り出す
+func parsePnpmWorkspacePackages(data []byte) []string {
+	var patterns []string
+	inPackages := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") && !strings.HasPrefix(line, "-") {
+			value, ok := strings.CutPrefix(trimmed, "packages:")
+			inPackages = ok
+			// `packages: ["apps/*", "packages/*"]` のようなフロー形式
+			if value = strings.TrimSpace(value); ok && strings.HasPrefix(value, "[") {
+				for item := range strings.SplitSeq(strings.Trim(value, "[]"), ",") {
+					if item = strings.Trim(strings.TrimSpace(item), `"'`); item != "" {
+						patterns = append(patterns, item)
+					}
+				}
+			}
+			continue
+		}
+
+		if !inPackages {
+			continue
+		}
+
+		if item, ok := strings.CutPrefix(trimmed, "-"); ok {
+			item = strings.Trim(strings.TrimSpace(item), `"'`)
+			if item != "" {
+				patterns = append(patterns, item)
+			}
+		}
+	}
+
+	return patterns
+}
+
+// parsePackageJSONWorkspaces は `package.json` の `workspaces` を取り出す
+//
+// npm / Yarn の配列形式と Yarn の `{ "packages": [...] }` 形式の両方に対応する
+func parsePackageJSONWorkspaces(data []byte) ([]string, error) {
+	var pkg struct {
+		Workspaces json.RawMessage `json:"workspaces"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+	if len(pkg.Workspaces) == 0 {
+		return nil, nil
+	}
+
+	var patterns []string
+	if err := json.Unmarshal(pkg.Workspaces, &patterns); err == nil {
+		return patterns, nil
+	}
+
+	var object struct {
+		Packages []string `json:"packages"`
+	}
+	if err := json.Unmarshal(pkg.Workspaces, &object); err != nil {
+		return nil, fmt.Errorf("failed to parse workspaces in package.json: %w", err)
+	}
+	return object.Packages, nil
+}
+
+func normalizeWorkspacePattern(pattern string) string {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+	return strings.TrimSuffix(pattern, "/")
+}
+
+func matchesAnyWorkspacePattern(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if matchWorkspacePattern(strings.Split(pattern, "/"), strings.Split(rel, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWorkspacePattern はセグメント単位でパターンを照合する
+//
+// `**` は 0 個以上のセグメントに一致し、それ以外のセグメントは path.Match で照合する
+func matchWorkspacePattern(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchWorkspacePattern(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	matched, err := path.Match(pattern[0], segments[0])
+	if err != nil || !matched {
+		return false
+	}
+	return matchWorkspacePattern(pattern[1:], segments[1:])
+}
@@ -0,0 +1,294 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for file, content := range files {
+		path := filepath.Join(root, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("ディレクトリの作成に失敗: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("ファイルの書き込みに失敗: %v", err)
+		}
+	}
+}
+
+func TestFindWorkspaceConfigs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name: "pnpm-workspace.yaml のパターンに一致するプロジェクト",
+			files: map[string]string{
+				"pnpm-workspace.yaml":            "packages:\n  - 'apps/*'\n  - \"packages/*\" # comment\n  - '!packages/ignored'\n",
+				"apps/api/wrangler.jsonc":        "{}",
+				"apps/web/wrangler.toml":         "",
+				"packages/queue/wrangler.json":   "{}",
+				"packages/ignored/wrangler.toml": "",
+				"tools/script/wrangler.toml":     "",
+			},
+			want: []string{
+				"apps/api/wrangler.jsonc",
+				"apps/web/wrangler.toml",
+				"packages/queue/wrangler.json",
+			},
+		},
+		{
+			name: "pnpm-workspace.yaml のフロー形式",
+			files: map[string]string{
+				"pnpm-workspace.yaml":     "packages: ['apps/*']\n",
+				"apps/api/wrangler.jsonc": "{}",
+				"libs/a/wrangler.jsonc":   "{}",
+			},
+			want: []string{"apps/api/wrangler.jsonc"},
+		},
+		{
+			name: "package.json の workspaces (配列形式)",
+			files: map[string]string{
+				"package.json":                    `{"workspaces": ["apps/**"]}`,
+				"apps/api/wrangler.jsonc":         "{}",
+				"apps/group/nested/wrangler.toml": "",
+				"other/wrangler.toml":             "",
+			},
+			want: []string{
+				"apps/api/wrangler.jsonc",
+				"apps/group/nested/wrangler.toml",
+			},
+		},
+		{
+			name: "package.json の workspaces (オブジェクト形式)",
+			files: map[string]string{
+				"package.json":            `{"workspaces": {"packages": ["./workers/*"]}}`,
+				"workers/a/wrangler.toml": "",
+				"apps/b/wrangler.toml":    "",
+			},
+			want: []string{"workers/a/wrangler.toml"},
+		},
+		{
+			name: "ワークスペースの定義がない場合はディレクトリを探索する",
+			files: map[string]string{
+				"wrangler.toml":                      "",
+				"apps/api/wrangler.jsonc":            "{}",
+				"node_modules/pkg/wrangler.toml":     "",
+				".wrangler/tmp/wrangler.toml":        "",
+				"a/b/c/d/e/f/wrangler.toml":          "",
+				"services/auth/wrangler.toml":        "",
+				"services/auth/src/handler/file.txt": "",
+			},
+			want: []string{
+				"wrangler.toml",
+				"apps/api/wrangler.jsonc",
+				"services/auth/wrangler.toml",
+			},
+		},
+		{
+			name: "リダイレクト先の生成された設定は別のプロジェクトとして扱わない",
+			files: map[string]string{
+				"apps/web/wrangler.jsonc":               `{"name": "source-web"}`,
+				"apps/web/.wrangler/deploy/config.json": `{"configPath": "../../dist/web/wrangler.json"}`,
+				"apps/web/dist/web/wrangler.json":       `{"name": "built-web"}`,
+				"apps/api/wrangler.toml":                "",
+			},
+			want: []string{
+				"apps/web/wrangler.jsonc",
+				"apps/api/wrangler.toml",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			writeFiles(t, root, tt.files)
+
+			got, err := FindWorkspaceConfigs(root)
+			if err != nil {
+				t.Fatalf("FindWorkspaceConfigs() error = %v", err)
+			}
+
+			want := make([]string, len(tt.want))
+			for i, path := range tt.want {
+				want[i] = filepath.Join(root, path)
+			}
+			slices.Sort(got)
+			slices.Sort(want)
+
+			if !slices.Equal(got, want) {
+				t.Errorf("FindWorkspaceConfigs() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFindWorkspaceConfigs_UnreadableDir(t *testing.T) {
+	t.Parallel()
+
+	if os.Geteuid() == 0 {
+		t.Skip("root はパーミッションに関係なくディレクトリを読み込めるためスキップ")
+	}
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"apps/api/wrangler.jsonc":     "{}",
+		"secret/nested/wrangler.toml": "",
+	})
+
+	secret := filepath.Join(root, "secret")
+	if err := os.Chmod(secret, 0o000); err != nil {
+		t.Fatalf("パーミッションの変更に失敗: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chmod(secret, 0o755)
+	})
+
+	got, err := FindWorkspaceConfigs(root)
+	if err != nil {
+		t.Fatalf("FindWorkspaceConfigs() error = %v", err)
+	}
+	if want := []string{filepath.Join(root, "apps/api/wrangler.jsonc")}; !slices.Equal(got, want) {
+		t.Errorf("FindWorkspaceConfigs() = %v, want %v", got, want)
+	}
+
+	if _, err := FindWorkspaceConfigs(filepath.Join(root, "missing")); err == nil {
+		t.Error("FindWorkspaceConfigs() expected error for missing root, got nil")
+	}
+}
+
+func TestFindWorkspaceRoot(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"repo/.git/HEAD":                 "",
+		"repo/package.json":              `{"name": "no-workspaces"}`,
+		"repo/mono/pnpm-workspace.yaml":  "packages:\n  - apps/*\n",
+		"repo/mono/apps/api/src/file.ts": "",
+		"repo/single/src/file.ts":        "",
+	})
+
+	tests := []struct {
+		name  string
+		start string
+		want  string
+	}{
+		{
+			name:  "pnpm-workspace.yaml があるディレクトリ",
+			start: "repo/mono/apps/api/src",
+			want:  "repo/mono",
+		},
+		{
+			name:  "ワークスペースの定義がなければリポジトリのルート",
+			start: "repo/single/src",
+			want:  "repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FindWorkspaceRoot(filepath.Join(root, tt.start))
+			if want := filepath.Join(root, tt.want); got != want {
+				t.Errorf("FindWorkspaceRoot() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadWranglerConfigs(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"a/wrangler.jsonc": `{"name": "worker-a"}`,
+		"b/wrangler.toml":  `name = "worker-b"`,
+		"c/wrangler.json":  `{"name": "worker-c"}`,
+	})
+
+	paths := []string{
+		filepath.Join(root, "a/wrangler.jsonc"),
+		filepath.Join(root, "b/wrangler.toml"),
+		filepath.Join(root, "c/wrangler.json"),
+	}
+
+	configs, err := LoadWranglerConfigs(paths)
+	if err != nil {
+		t.Fatalf("LoadWranglerConfigs() error = %v", err)
+	}
+
+	wantNames := []string{"worker-a", "worker-b", "worker-c"}
+	for i, cfg := range configs {
+		if cfg.Name != wantNames[i] {
+			t.Errorf("configs[%d].Name = %q, want %q", i, cfg.Name, wantNames[i])
+		}
+		if cfg.ConfigPath != paths[i] {
+			t.Errorf("configs[%d].ConfigPath = %q, want %q", i, cfg.ConfigPath, paths[i])
+		}
+	}
+
+	if _, err := LoadWranglerConfigs([]string{paths[0], filepath.Join(root, "missing/wrangler.toml")}); err == nil {
+		t.Error("LoadWranglerConfigs() expected error for missing file, got nil")
+	}
+}
+
+func TestLoadWranglerConfigs_Redirect(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"apps/web/wrangler.jsonc":                  `{"name": "source-web"}`,
+		"apps/web/.wrangler/deploy/config.json":    `{"configPath": "../../dist/web/wrangler.json"}`,
+		"apps/web/dist/web/wrangler.json":          `{"name": "built-web"}`,
+		"apps/api/wrangler.toml":                   `name = "api"`,
+		"apps/broken/wrangler.jsonc":               `{"name": "broken"}`,
+		"apps/broken/.wrangler/deploy/config.json": `{"configPath": "../../dist/wrangler.json"}`,
+	})
+
+	paths := []string{
+		filepath.Join(root, "apps/web/wrangler.jsonc"),
+		filepath.Join(root, "apps/api/wrangler.toml"),
+	}
+
+	configs, err := LoadWranglerConfigs(paths)
+	if err != nil {
+		t.Fatalf("LoadWranglerConfigs() error = %v", err)
+	}
+
+	web := configs[0]
+	if web.Name != "built-web" {
+		t.Errorf("configs[0].Name = %q, want %q", web.Name, "built-web")
+	}
+	if want := filepath.Join(root, "apps/web/dist/web/wrangler.json"); web.ConfigPath != want {
+		t.Errorf("configs[0].ConfigPath = %q, want %q", web.ConfigPath, want)
+	}
+	if want := filepath.Join(root, "apps/web/.wrangler/deploy/config.json"); web.DeployConfigPath != want {
+		t.Errorf("configs[0].DeployConfigPath = %q, want %q", web.DeployConfigPath, want)
+	}
+	if want := filepath.Join(root, "apps/web"); web.ProjectDir() != want {
+		t.Errorf("configs[0].ProjectDir() = %q, want %q", web.ProjectDir(), want)
+	}
+
+	api := configs[1]
+	if api.Name != "api" || api.IsRedirected() {
+		t.Errorf("configs[1] = {Name: %q, IsRedirected: %v}, want {Name: %q, IsRedirected: false}", api.Name, api.IsRedirected(), "api")
+	}
+
+	// リダイレクト先が存在しない場合はエラー
+	if _, err := LoadWranglerConfigs([]string{filepath.Join(root, "apps/broken/wrangler.jsonc")}); err == nil {
+		t.Error("LoadWranglerConfigs() expected error for missing redirect target, got nil")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/BurntSushi/toml"
@@ -16,20 +17,78 @@ type WranglerConfig struct {
 	AccountID         string         `json:"account_id" toml:"account_id"`
 	CompatibilityDate string         `json:"compatibility_date" toml:"compatibility_date"`
 	Vars              map[string]any `json:"vars" toml:"vars"`
+	Route             *Route         `json:"route" toml:"route"`
+	Routes            []Route        `json:"routes" toml:"routes"`
 
-	Observability       *ObservabilityConfig `json:"observability" toml:"observability"`
-	Triggers            *TriggersConfig      `json:"triggers" toml:"triggers"`
-	Queues              *QueuesConfig        `json:"queues" toml:"queues"`
-	Workflows           []Workflow           `json:"workflows" toml:"workflows"`
-	Browser             *BrowserConfig       `json:"browser" toml:"browser"`
-	VPCServices         []VPCService         `json:"vpc_services" toml:"vpc_services"`
-	R2Buckets           []R2Bucket           `json:"r2_buckets" toml:"r2_buckets"`
-	KVNamespaces        []KVNamespace        `json:"kv_namespaces" toml:"kv_namespaces"`
-	D1Databases         []D1Database         `json:"d1_databases" toml:"d1_databases"`
-	Pipelines           []Pipeline           `json:"pipelines" toml:"pipelines"`
-	Vectorize           []VectorizeIndex     `json:"vectorize" toml:"vectorize"`
-	SecretsStoreSecrets []SecretsStoreSecret `json:"secrets_store_secrets" toml:"secrets_store_secrets"`
-	Images              *ImagesConfig        `json:"images" toml:"images"`
+	PagesBuildOutputDir string `json:"pages_build_output_dir" toml:"pages_build_output_dir"`
+
+	Observability       *ObservabilityConfig     `json:"observability" toml:"observability"`
+	Triggers            *TriggersConfig          `json:"triggers" toml:"triggers"`
+	TailConsumers       []TailConsumer           `json:"tail_consumers" toml:"tail_consumers"`
+	Logpush             *bool                    `json:"logpush" toml:"logpush"`
+	DurableObjects      *DurableObjectsConfig    `json:"durable_objects" toml:"durable_objects"`
+	Migrations          []Migration              `json:"migrations" toml:"migrations"`
+	Services            []ServiceBinding         `json:"services" toml:"services"`
+	Queues              *QueuesConfig            `json:"queues" toml:"queues"`
+	Workflows           []Workflow               `json:"workflows" toml:"workflows"`
+	Browser             *BrowserConfig           `json:"browser" toml:"browser"`
+	VPCServices         []VPCService             `json:"vpc_services" toml:"vpc_services"`
+	R2Buckets           []R2Bucket               `json:"r2_buckets" toml:"r2_buckets"`
+	KVNamespaces        []KVNamespace            `json:"kv_namespaces" toml:"kv_namespaces"`
+	D1Databases         []D1Database             `json:"d1_databases" toml:"d1_databases"`
+	Hyperdrive          []Hyperdrive             `json:"hyperdrive" toml:"hyperdrive"`
+	Pipelines           []Pipeline               `json:"pipelines" toml:"pipelines"`
+	Vectorize           []VectorizeIndex         `json:"vectorize" toml:"vectorize"`
+	AI                  *AIConfig                `json:"ai" toml:"ai"`
+	AnalyticsEngine     []AnalyticsEngineDataset `json:"analytics_engine_datasets" toml:"analytics_engine_datasets"`
+	SecretsStoreSecrets []SecretsStoreSecret     `json:"secrets_store_secrets" toml:"secrets_store_secrets"`
+	Images              *ImagesConfig            `json:"images" toml:"images"`
+	SendEmail           []SendEmailBinding       `json:"send_email" toml:"send_email"`
+	MTLSCertificates    []MTLSCertificate        `json:"mtls_certificates" toml:"mtls_certificates"`
+	RateLimits          []RateLimit              `json:"ratelimits" toml:"ratelimits"`
+	Unsafe              *UnsafeConfig            `json:"unsafe" toml:"unsafe"`
+	Assets              *AssetsConfig            `json:"assets" toml:"assets"`
+	Containers          []Container              `json:"containers" toml:"containers"`
+	DispatchNamespaces  []DispatchNamespace      `json:"dispatch_namespaces" toml:"dispatch_namespaces"`
+
+	Env map[string]*WranglerConfig `json:"env" toml:"env"`
+
+	// ConfigPath は読み込んだ設定ファイルのパス
+	ConfigPath string `json:"-" toml:"-"`
+	// DeployConfigPath はリダイレクトされた設定を読み込んだ場合の `.wrangler/deploy/config.json` のパス
+	DeployConfigPath string `json:"-" toml:"-"`
+}
+
+// IsRedirected はビルドツールが生成した設定にリダイレクトされているかを返す
+func (c *WranglerConfig) IsRedirected() bool {
+	return c.DeployConfigPath != ""
+}
+
+// ProjectDir は設定ファイルが置かれているプロジェクトのルートディレクトリを返す
+func (c *WranglerConfig) ProjectDir() string {
+	if c.IsRedirected() {
+		return projectDirOfDeployConfig(c.DeployConfigPath)
+	}
+	if c.ConfigPath == "" {
+		return "."
+	}
+	return filepath.Dir(c.ConfigPath)
+}
+
+// IsPages は設定が Worker ではなく Pages プロジェクトのものかを返す
+//
+// `pages_build_output_dir` は Pages プロジェクトの設定でのみ指定される
+func (c *WranglerConfig) IsPages() bool {
+	return c.PagesBuildOutputDir != ""
+}
+
+// AllRoutes は `route` と `routes` に指定されたすべてのルートを返す
+func (c *WranglerConfig) AllRoutes() []Route {
+	var routes []Route
+	if c.Route != nil {
+		routes = append(routes, *c.Route)
+	}
+	return append(routes, c.Routes...)
 }
 
 type ObservabilityConfig struct {
@@ -40,8 +99,65 @@ type TriggersConfig struct {
 	Crons []string `json:"crons" toml:"crons"`
 }
 
+type TailConsumer struct {
+	Service     string `json:"service" toml:"service"`
+	Environment string `json:"environment" toml:"environment"`
+}
+
+type DurableObjectsConfig struct {
+	Bindings []DurableObjectBinding `json:"bindings" toml:"bindings"`
+}
+
+type DurableObjectBinding struct {
+	Name        string `json:"name" toml:"name"`
+	ClassName   string `json:"class_name" toml:"class_name"`
+	ScriptName  string `json:"script_name" toml:"script_name"`
+	Environment string `json:"environment" toml:"environment"`
+}
+
+type Migration struct {
+	Tag              string         `json:"tag" toml:"tag"`
+	NewClasses       []string       `json:"new_classes" toml:"new_classes"`
+	NewSqliteClasses []string       `json:"new_sqlite_classes" toml:"new_sqlite_classes"`
+	RenamedClasses   []RenamedClass `json:"renamed_classes" toml:"renamed_classes"`
+	DeletedClasses   []string       `json:"deleted_classes" toml:"deleted_classes"`
+}
+
+type RenamedClass struct {
+	From string `json:"from" toml:"from"`
+	To   string `json:"to" toml:"to"`
+}
+
+// IsSQLiteDurableObject は migrations を順に適用し、className が SQLite ストレージの Durable Object かを判定する
+func (c *WranglerConfig) IsSQLiteDurableObject(className string) bool {
+	sqliteClasses := make(map[string]bool)
+	for _, migration := range c.Migrations {
+		for _, class := range migration.NewSqliteClasses {
+			sqliteClasses[class] = true
+		}
+		for _, renamed := range migration.RenamedClasses {
+			if sqliteClasses[renamed.From] {
+				delete(sqliteClasses, renamed.From)
+				sqliteClasses[renamed.To] = true
+			}
+		}
+		for _, class := range migration.DeletedClasses {
+			delete(sqliteClasses, class)
+		}
+	}
+	return sqliteClasses[className]
+}
+
+type ServiceBinding struct {
+	Binding     string `json:"binding" toml:"binding"`
+	Service     string `json:"service" toml:"service"`
+	Environment string `json:"environment" toml:"environment"`
+	Entrypoint  string `json:"entrypoint" toml:"entrypoint"`
+}
+
 type QueuesConfig struct {
 	Producers []QueueProducer `json:"producers" toml:"producers"`
+	Consumers []QueueConsumer `json:"consumers" toml:"consumers"`
 }
 
 type QueueProducer struct {
@@ -49,6 +165,18 @@ type QueueProducer struct {
 	Queue   string `json:"queue" toml:"queue"`
 }
 
+type QueueConsumer struct {
+	Queue               string `json:"queue" toml:"queue"`
+	Type                string `json:"type" toml:"type"`
+	DeadLetterQueue     string `json:"dead_letter_queue" toml:"dead_letter_queue"`
+	MaxBatchSize        int    `json:"max_batch_size" toml:"max_batch_size"`
+	MaxBatchTimeout     int    `json:"max_batch_timeout" toml:"max_batch_timeout"`
+	MaxRetries          int    `json:"max_retries" toml:"max_retries"`
+	MaxConcurrency      int    `json:"max_concurrency" toml:"max_concurrency"`
+	RetryDelay          int    `json:"retry_delay" toml:"retry_delay"`
+	VisibilityTimeoutMs int    `json:"visibility_timeout_ms" toml:"visibility_timeout_ms"`
+}
+
 type Workflow struct {
 	Binding   string `json:"binding" toml:"binding"`
 	Name      string `json:"name" toml:"name"`
@@ -65,19 +193,29 @@ type VPCService struct {
 }
 
 type R2Bucket struct {
-	Binding    string `json:"binding" toml:"binding"`
-	BucketName string `json:"bucket_name" toml:"bucket_name"`
+	Binding           string `json:"binding" toml:"binding"`
+	BucketName        string `json:"bucket_name" toml:"bucket_name"`
+	PreviewBucketName string `json:"preview_bucket_name" toml:"preview_bucket_name"`
+	Jurisdiction      string `json:"jurisdiction" toml:"jurisdiction"`
 }
 
 type KVNamespace struct {
-	Binding string `json:"binding" toml:"binding"`
-	ID      string `json:"id" toml:"id"`
+	Binding   string `json:"binding" toml:"binding"`
+	ID        string `json:"id" toml:"id"`
+	PreviewID string `json:"preview_id" toml:"preview_id"`
 }
 
 type D1Database struct {
-	Binding      string `json:"binding" toml:"binding"`
-	DatabaseName string `json:"database_name" toml:"database_name"`
-	DatabaseID   string `json:"database_id" toml:"database_id"`
+	Binding           string `json:"binding" toml:"binding"`
+	DatabaseName      string `json:"database_name" toml:"database_name"`
+	DatabaseID        string `json:"database_id" toml:"database_id"`
+	PreviewDatabaseID string `json:"preview_database_id" toml:"preview_database_id"`
+}
+
+type Hyperdrive struct {
+	Binding               string `json:"binding" toml:"binding"`
+	ID                    string `json:"id" toml:"id"`
+	LocalConnectionString string `json:"localConnectionString" toml:"localConnectionString"`
 }
 
 type Pipeline struct {
@@ -90,6 +228,23 @@ type VectorizeIndex struct {
 	IndexName string `json:"index_name" toml:"index_name"`
 }
 
+type AIConfig struct {
+	Binding string `json:"binding" toml:"binding"`
+}
+
+type AnalyticsEngineDataset struct {
+	Binding string `json:"binding" toml:"binding"`
+	Dataset string `json:"dataset" toml:"dataset"`
+}
+
+// DatasetName はデータセット名を返す。未指定の場合は Wrangler と同様にバインディング名を使う
+func (d AnalyticsEngineDataset) DatasetName() string {
+	if d.Dataset != "" {
+		return d.Dataset
+	}
+	return d.Binding
+}
+
 type SecretsStoreSecret struct {
 	Binding    string `json:"binding" toml:"binding"`
 	StoreID    string `json:"store_id" toml:"store_id"`
@@ -100,14 +255,122 @@ type ImagesConfig struct {
 	Binding string `json:"binding" toml:"binding"`
 }
 
+type SendEmailBinding struct {
+	Name                        string   `json:"name" toml:"name"`
+	DestinationAddress          string   `json:"destination_address" toml:"destination_address"`
+	AllowedDestinationAddresses []string `json:"allowed_destination_addresses" toml:"allowed_destination_addresses"`
+}
+
+// Destinations は送信先のアドレスを返す
+//
+// `destination_address` がない場合は `allowed_destination_addresses` を返す
+func (b SendEmailBinding) Destinations() []string {
+	if b.DestinationAddress != "" {
+		return []string{b.DestinationAddress}
+	}
+	return b.AllowedDestinationAddresses
+}
+
+type MTLSCertificate struct {
+	Binding       string `json:"binding" toml:"binding"`
+	CertificateID string `json:"certificate_id" toml:"certificate_id"`
+}
+
+type RateLimit struct {
+	Name        string           `json:"name" toml:"name"`
+	NamespaceID string           `json:"namespace_id" toml:"namespace_id"`
+	Simple      *RateLimitSimple `json:"simple" toml:"simple"`
+}
+
+type RateLimitSimple struct {
+	Limit  int `json:"limit" toml:"limit"`
+	Period int `json:"period" toml:"period"`
+}
+
+type UnsafeConfig struct {
+	Bindings []UnsafeBinding `json:"bindings" toml:"bindings"`
+}
+
+// UnsafeBinding は `unsafe.bindings` の要素のうち cf-open が扱うフィールドのみを持つ
+type UnsafeBinding struct {
+	Name        string           `json:"name" toml:"name"`
+	Type        string           `json:"type" toml:"type"`
+	NamespaceID string           `json:"namespace_id" toml:"namespace_id"`
+	Simple      *RateLimitSimple `json:"simple" toml:"simple"`
+}
+
+// AllRateLimits は `ratelimits` と `type = "ratelimit"` の `unsafe.bindings` をまとめて返す
+func (c *WranglerConfig) AllRateLimits() []RateLimit {
+	rateLimits := slices.Clone(c.RateLimits)
+	if c.Unsafe == nil {
+		return rateLimits
+	}
+	for _, binding := range c.Unsafe.Bindings {
+		if binding.Type != "ratelimit" {
+			continue
+		}
+		rateLimits = append(rateLimits, RateLimit{
+			Name:        binding.Name,
+			NamespaceID: binding.NamespaceID,
+			Simple:      binding.Simple,
+		})
+	}
+	return rateLimits
+}
+
+type AssetsConfig struct {
+	Directory        string `json:"directory" toml:"directory"`
+	Binding          string `json:"binding" toml:"binding"`
+	NotFoundHandling string `json:"not_found_handling" toml:"not_found_handling"`
+	HTMLHandling     string `json:"html_handling" toml:"html_handling"`
+}
+
+type Container struct {
+	ClassName    string `json:"class_name" toml:"class_name"`
+	Image        string `json:"image" toml:"image"`
+	Name         string `json:"name" toml:"name"`
+	MaxInstances int    `json:"max_instances" toml:"max_instances"`
+}
+
+// ApplicationName は Containers のアプリケーション名を返す
+//
+// 未指定の場合は Wrangler と同様に `<worker 名>-<クラス名の小文字>` になる
+func (c Container) ApplicationName(workerName string) string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return fmt.Sprintf("%s-%s", workerName, strings.ToLower(c.ClassName))
+}
+
+type DispatchNamespace struct {
+	Binding   string            `json:"binding" toml:"binding"`
+	Namespace string            `json:"namespace" toml:"namespace"`
+	Outbound  *DispatchOutbound `json:"outbound" toml:"outbound"`
+}
+
+type DispatchOutbound struct {
+	Service     string `json:"service" toml:"service"`
+	Environment string `json:"environment" toml:"environment"`
+}
+
 func LoadWranglerConfig(configPath string) (*WranglerConfig, error) {
+	deployConfigPath := ""
 	if configPath == "" {
-		configPath = findWranglerConfig()
+		var err error
+		configPath, deployConfigPath, err = findWranglerConfig()
+		if err != nil {
+			return nil, err
+		}
 		if configPath == "" {
 			return nil, fmt.Errorf("wrangler config file not found")
 		}
 	}
 
+	return loadWranglerConfigFile(configPath, deployConfigPath)
+}
+
+// loadWranglerConfigFile は設定ファイルを読み込む。deployConfigPath はリダイレクトされた場合のリダイレクト用ファイルのパス
+func loadWranglerConfigFile(configPath, deployConfigPath string) (*WranglerConfig, error) {
 	data, err := os.ReadFile(configPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
@@ -129,10 +392,48 @@ func LoadWranglerConfig(configPath string) (*WranglerConfig, error) {
 		return nil, fmt.Errorf("unsupported config file format: %s", ext)
 	}
 
+	config.ConfigPath = configPath
+	config.DeployConfigPath = deployConfigPath
+
 	return config, nil
 }
 
-func findWranglerConfig() string {
+func findWranglerConfig() (string, string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	return findWranglerConfigWithRedirect(cwd)
+}
+
+// findWranglerConfigFrom は Wrangler と同様に dir から親ディレクトリへ遡って設定ファイルを探す
+func findWranglerConfigFrom(dir string) string {
+	return findUp(dir, findWranglerConfigIn)
+}
+
+// findUp は dir から親ディレクトリへ遡り、find が最初に返した空でないパスを返す
+//
+// リポジトリのルート (`.git` があるディレクトリ) かファイルシステムのルートに達したら探索をやめる
+func findUp(dir string, find func(dir string) string) string {
+	for {
+		if path := find(dir); path != "" {
+			return path
+		}
+
+		if isRepositoryRoot(dir) {
+			return ""
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// findWranglerConfigIn は dir の直下にある設定ファイルを探す
+func findWranglerConfigIn(dir string) string {
 	candidates := []string{
 		"wrangler.jsonc",
 		"wrangler.json",
@@ -140,10 +441,16 @@ func findWranglerConfig() string {
 	}
 
 	for _, candidate := range candidates {
-		if _, err := os.Stat(candidate); err == nil {
-			return candidate
+		path := filepath.Join(dir, candidate)
+		if _, err := os.Stat(path); err == nil {
+			return path
 		}
 	}
 
 	return ""
 }
+
+func isRepositoryRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ".git"))
+	return err == nil
+}
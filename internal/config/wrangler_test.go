@@ -58,6 +58,22 @@ compatibility_date = "2024-01-01"
 				}
 			},
 		},
+		{
+			name:     "TOML の Pages プロジェクト",
+			filename: "wrangler.toml",
+			content: `
+name = "my-pages"
+pages_build_output_dir = "./dist"
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if !cfg.IsPages() {
+					t.Error("IsPages() = false, want true")
+				}
+				if cfg.PagesBuildOutputDir != "./dist" {
+					t.Errorf("PagesBuildOutputDir = %q, want %q", cfg.PagesBuildOutputDir, "./dist")
+				}
+			},
+		},
 		{
 			name:     "JSON で Observability を含む設定",
 			filename: "wrangler.json",
@@ -225,6 +241,25 @@ compatibility_date = "2024-01-01"
 				}
 			},
 		},
+		{
+			name:     "JSON で Hyperdrive を含む設定",
+			filename: "wrangler.json",
+			content: `{
+				"name": "hyperdrive-worker",
+				"hyperdrive": [
+					{"binding": "HYPERDRIVE", "id": "hyperdrive-123"}
+				]
+			}`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.Hyperdrive) != 1 {
+					t.Errorf("len(Hyperdrive) = %d, want 1", len(cfg.Hyperdrive))
+					return
+				}
+				if cfg.Hyperdrive[0].ID != "hyperdrive-123" {
+					t.Errorf("Hyperdrive[0].ID = %q, want %q", cfg.Hyperdrive[0].ID, "hyperdrive-123")
+				}
+			},
+		},
 		{
 			name:     "JSON で Pipelines を含む設定",
 			filename: "wrangler.json",
@@ -263,6 +298,33 @@ compatibility_date = "2024-01-01"
 				}
 			},
 		},
+		{
+			name:     "JSON で Workers AI と Analytics Engine を含む設定",
+			filename: "wrangler.json",
+			content: `{
+				"name": "inference-worker",
+				"ai": {"binding": "AI"},
+				"analytics_engine_datasets": [
+					{"binding": "EVENTS", "dataset": "events_dataset"},
+					{"binding": "METRICS"}
+				]
+			}`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.AI == nil || cfg.AI.Binding != "AI" {
+					t.Errorf("AI = %+v, want binding AI", cfg.AI)
+				}
+				if len(cfg.AnalyticsEngine) != 2 {
+					t.Errorf("len(AnalyticsEngine) = %d, want 2", len(cfg.AnalyticsEngine))
+					return
+				}
+				if got := cfg.AnalyticsEngine[0].DatasetName(); got != "events_dataset" {
+					t.Errorf("AnalyticsEngine[0].DatasetName() = %q, want %q", got, "events_dataset")
+				}
+				if got := cfg.AnalyticsEngine[1].DatasetName(); got != "METRICS" {
+					t.Errorf("AnalyticsEngine[1].DatasetName() = %q, want %q", got, "METRICS")
+				}
+			},
+		},
 		{
 			name:     "JSON で Secrets Store を含む設定",
 			filename: "wrangler.json",
@@ -319,6 +381,40 @@ id = "kv-id-2"
 				}
 			},
 		},
+		{
+			name:     "TOML でプレビュー用の識別子を含む設定",
+			filename: "wrangler.toml",
+			content: `
+name = "preview-worker"
+
+[[kv_namespaces]]
+binding = "KV"
+id = "kv-id"
+preview_id = "kv-preview-id"
+
+[[d1_databases]]
+binding = "DB"
+database_name = "db"
+database_id = "d1-id"
+preview_database_id = "d1-preview-id"
+
+[[r2_buckets]]
+binding = "BUCKET"
+bucket_name = "bucket"
+preview_bucket_name = "bucket-preview"
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.KVNamespaces) != 1 || cfg.KVNamespaces[0].PreviewID != "kv-preview-id" {
+					t.Errorf("KVNamespaces = %+v, want preview_id kv-preview-id", cfg.KVNamespaces)
+				}
+				if len(cfg.D1Databases) != 1 || cfg.D1Databases[0].PreviewDatabaseID != "d1-preview-id" {
+					t.Errorf("D1Databases = %+v, want preview_database_id d1-preview-id", cfg.D1Databases)
+				}
+				if len(cfg.R2Buckets) != 1 || cfg.R2Buckets[0].PreviewBucketName != "bucket-preview" {
+					t.Errorf("R2Buckets = %+v, want preview_bucket_name bucket-preview", cfg.R2Buckets)
+				}
+			},
+		},
 		{
 			name:     "TOML で Queues を含む設定",
 			filename: "wrangler.toml",
@@ -340,6 +436,33 @@ queue = "my-queue"
 				}
 			},
 		},
+		{
+			name:     "TOML で Queue consumers を含む設定",
+			filename: "wrangler.toml",
+			content: `
+name = "consumer-worker"
+
+[[queues.consumers]]
+queue = "my-queue"
+dead_letter_queue = "my-dlq"
+max_batch_size = 10
+max_batch_timeout = 30
+max_retries = 3
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.Queues == nil || len(cfg.Queues.Consumers) != 1 {
+					t.Errorf("Queues = %+v, want 1 consumer", cfg.Queues)
+					return
+				}
+				consumer := cfg.Queues.Consumers[0]
+				if consumer.Queue != "my-queue" || consumer.DeadLetterQueue != "my-dlq" {
+					t.Errorf("Queues.Consumers[0] = %+v, want queue my-queue with dlq my-dlq", consumer)
+				}
+				if consumer.MaxBatchSize != 10 || consumer.MaxBatchTimeout != 30 || consumer.MaxRetries != 3 {
+					t.Errorf("Queues.Consumers[0] = %+v, want batch settings 10/30/3", consumer)
+				}
+			},
+		},
 		{
 			name:     "TOML で Triggers を含む設定",
 			filename: "wrangler.toml",
@@ -359,6 +482,192 @@ crons = ["0 * * * *"]
 				}
 			},
 		},
+		{
+			name:     "JSON で Durable Objects を含む設定",
+			filename: "wrangler.json",
+			content: `{
+				"name": "do-worker",
+				"durable_objects": {
+					"bindings": [
+						{"name": "COUNTER", "class_name": "Counter"},
+						{"name": "ROOM", "class_name": "ChatRoom", "script_name": "chat-worker"}
+					]
+				},
+				"migrations": [
+					{"tag": "v1", "new_sqlite_classes": ["Counter"]}
+				]
+			}`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.DurableObjects == nil {
+					t.Error("DurableObjects is nil")
+					return
+				}
+				if len(cfg.DurableObjects.Bindings) != 2 {
+					t.Errorf("len(DurableObjects.Bindings) = %d, want 2", len(cfg.DurableObjects.Bindings))
+					return
+				}
+				if cfg.DurableObjects.Bindings[1].ScriptName != "chat-worker" {
+					t.Errorf("DurableObjects.Bindings[1].ScriptName = %q, want %q", cfg.DurableObjects.Bindings[1].ScriptName, "chat-worker")
+				}
+				if len(cfg.Migrations) != 1 || cfg.Migrations[0].Tag != "v1" {
+					t.Errorf("Migrations = %+v, want tag v1", cfg.Migrations)
+				}
+			},
+		},
+		{
+			name:     "TOML で Durable Objects を含む設定",
+			filename: "wrangler.toml",
+			content: `
+name = "do-toml-worker"
+
+[[durable_objects.bindings]]
+name = "COUNTER"
+class_name = "Counter"
+
+[[migrations]]
+tag = "v1"
+new_classes = ["Counter"]
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.DurableObjects == nil || len(cfg.DurableObjects.Bindings) != 1 {
+					t.Errorf("DurableObjects = %+v, want 1 binding", cfg.DurableObjects)
+					return
+				}
+				if cfg.DurableObjects.Bindings[0].ClassName != "Counter" {
+					t.Errorf("DurableObjects.Bindings[0].ClassName = %q, want %q", cfg.DurableObjects.Bindings[0].ClassName, "Counter")
+				}
+				if len(cfg.Migrations) != 1 || len(cfg.Migrations[0].NewClasses) != 1 {
+					t.Errorf("Migrations = %+v, want 1 migration with new_classes", cfg.Migrations)
+				}
+			},
+		},
+		{
+			name:     "TOML で Service Bindings を含む設定",
+			filename: "wrangler.toml",
+			content: `
+name = "gateway"
+services = [
+  { binding = "AUTH", service = "auth-worker", environment = "production", entrypoint = "AuthEntrypoint" }
+]
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.Services) != 1 {
+					t.Errorf("len(Services) = %d, want 1", len(cfg.Services))
+					return
+				}
+				want := ServiceBinding{Binding: "AUTH", Service: "auth-worker", Environment: "production", Entrypoint: "AuthEntrypoint"}
+				if cfg.Services[0] != want {
+					t.Errorf("Services[0] = %+v, want %+v", cfg.Services[0], want)
+				}
+			},
+		},
+		{
+			name:     "JSON で Assets / Containers / Dispatch Namespaces を含む設定",
+			filename: "wrangler.jsonc",
+			content: `{
+				"name": "platform-worker",
+				"assets": {"directory": "./public", "binding": "ASSETS", "not_found_handling": "single-page-application"},
+				"containers": [
+					{"class_name": "MyContainer", "image": "./Dockerfile", "max_instances": 5}
+				],
+				"dispatch_namespaces": [
+					{"binding": "DISPATCHER", "namespace": "customers", "outbound": {"service": "outbound-worker"}}
+				]
+			}`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.Assets == nil || cfg.Assets.Directory != "./public" {
+					t.Errorf("Assets = %+v, want directory ./public", cfg.Assets)
+				}
+				if len(cfg.Containers) != 1 {
+					t.Errorf("len(Containers) = %d, want 1", len(cfg.Containers))
+					return
+				}
+				if got := cfg.Containers[0].ApplicationName(cfg.Name); got != "platform-worker-mycontainer" {
+					t.Errorf("Containers[0].ApplicationName() = %q, want %q", got, "platform-worker-mycontainer")
+				}
+				if cfg.Containers[0].MaxInstances != 5 {
+					t.Errorf("Containers[0].MaxInstances = %d, want 5", cfg.Containers[0].MaxInstances)
+				}
+				if len(cfg.DispatchNamespaces) != 1 {
+					t.Errorf("len(DispatchNamespaces) = %d, want 1", len(cfg.DispatchNamespaces))
+					return
+				}
+				namespace := cfg.DispatchNamespaces[0]
+				if namespace.Namespace != "customers" || namespace.Outbound == nil || namespace.Outbound.Service != "outbound-worker" {
+					t.Errorf("DispatchNamespaces[0] = %+v, want namespace customers with outbound-worker", namespace)
+				}
+			},
+		},
+		{
+			name:     "TOML で Tail Consumers / Logpush / Send Email を含む設定",
+			filename: "wrangler.toml",
+			content: `
+name = "observed-worker"
+logpush = true
+tail_consumers = [{ service = "tail-worker" }]
+
+[[send_email]]
+name = "EMAIL"
+destination_address = "alerts@example.com"
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if cfg.Logpush == nil || !*cfg.Logpush {
+					t.Errorf("Logpush = %v, want true", cfg.Logpush)
+				}
+				if len(cfg.TailConsumers) != 1 || cfg.TailConsumers[0].Service != "tail-worker" {
+					t.Errorf("TailConsumers = %+v, want tail-worker", cfg.TailConsumers)
+				}
+				if len(cfg.SendEmail) != 1 {
+					t.Errorf("len(SendEmail) = %d, want 1", len(cfg.SendEmail))
+					return
+				}
+				if got := cfg.SendEmail[0].Destinations(); len(got) != 1 || got[0] != "alerts@example.com" {
+					t.Errorf("SendEmail[0].Destinations() = %v, want [alerts@example.com]", got)
+				}
+			},
+		},
+		{
+			name:     "TOML で mTLS Certificates と Rate Limiting を含む設定",
+			filename: "wrangler.toml",
+			content: `
+name = "secure-worker"
+
+[[mtls_certificates]]
+binding = "MY_CERT"
+certificate_id = "cert-id-123"
+
+[[ratelimits]]
+name = "LIMITER"
+namespace_id = "1001"
+simple = { limit = 100, period = 60 }
+
+[[unsafe.bindings]]
+name = "LEGACY_LIMITER"
+type = "ratelimit"
+namespace_id = "1002"
+simple = { limit = 10, period = 10 }
+
+[[unsafe.bindings]]
+name = "OTHER"
+type = "something_else"
+`,
+			validate: func(t *testing.T, cfg *WranglerConfig) {
+				if len(cfg.MTLSCertificates) != 1 || cfg.MTLSCertificates[0].CertificateID != "cert-id-123" {
+					t.Errorf("MTLSCertificates = %+v, want cert-id-123", cfg.MTLSCertificates)
+				}
+				rateLimits := cfg.AllRateLimits()
+				if len(rateLimits) != 2 {
+					t.Errorf("len(AllRateLimits()) = %d, want 2", len(rateLimits))
+					return
+				}
+				if rateLimits[0].Simple == nil || rateLimits[0].Simple.Limit != 100 {
+					t.Errorf("AllRateLimits()[0] = %+v, want limit 100", rateLimits[0])
+				}
+				if rateLimits[1].Name != "LEGACY_LIMITER" || rateLimits[1].NamespaceID != "1002" {
+					t.Errorf("AllRateLimits()[1] = %+v, want LEGACY_LIMITER", rateLimits[1])
+				}
+			},
+		},
 		{
 			name:     "無効な JSON",
 			filename: "wrangler.json",
@@ -401,6 +710,41 @@ crons = ["0 * * * *"]
 	}
 }
 
+func TestWranglerConfig_IsSQLiteDurableObject(t *testing.T) {
+	t.Parallel()
+
+	cfg := &WranglerConfig{
+		Migrations: []Migration{
+			{Tag: "v1", NewSqliteClasses: []string{"Counter", "Old"}, NewClasses: []string{"KVBacked"}},
+			{Tag: "v2", RenamedClasses: []RenamedClass{{From: "Old", To: "Renamed"}}},
+			{Tag: "v3", NewSqliteClasses: []string{"Temp"}},
+			{Tag: "v4", DeletedClasses: []string{"Temp"}},
+		},
+	}
+
+	tests := []struct {
+		className string
+		want      bool
+	}{
+		{className: "Counter", want: true},
+		{className: "KVBacked", want: false},
+		{className: "Old", want: false},
+		{className: "Renamed", want: true},
+		{className: "Temp", want: false},
+		{className: "Unknown", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.className, func(t *testing.T) {
+			t.Parallel()
+
+			if got := cfg.IsSQLiteDurableObject(tt.className); got != tt.want {
+				t.Errorf("IsSQLiteDurableObject(%q) = %v, want %v", tt.className, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestLoadWranglerConfig_FileNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -418,3 +762,72 @@ func TestLoadWranglerConfig_EmptyPath(t *testing.T) {
 		t.Error("LoadWranglerConfig() expected error for empty path with no wrangler config, got nil")
 	}
 }
+
+func TestFindWranglerConfigFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		files []string
+		start string
+		want  string
+	}{
+		{
+			name:  "カレントディレクトリにある場合",
+			files: []string{"project/wrangler.toml"},
+			start: "project",
+			want:  "project/wrangler.toml",
+		},
+		{
+			name:  "親ディレクトリへ遡って見つける",
+			files: []string{"project/wrangler.jsonc", "project/src/handlers/.keep"},
+			start: "project/src/handlers",
+			want:  "project/wrangler.jsonc",
+		},
+		{
+			name:  "最も近いディレクトリの設定を優先する",
+			files: []string{"project/wrangler.toml", "project/apps/api/wrangler.json", "project/apps/api/src/.keep"},
+			start: "project/apps/api/src",
+			want:  "project/apps/api/wrangler.json",
+		},
+		{
+			name:  "同じディレクトリでは wrangler.jsonc を優先する",
+			files: []string{"project/wrangler.toml", "project/wrangler.jsonc"},
+			start: "project",
+			want:  "project/wrangler.jsonc",
+		},
+		{
+			name:  "リポジトリのルートより上は探さない",
+			files: []string{"wrangler.toml", "project/.git/HEAD", "project/src/.keep"},
+			start: "project/src",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			for _, file := range tt.files {
+				path := filepath.Join(root, file)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatalf("ディレクトリの作成に失敗: %v", err)
+				}
+				if err := os.WriteFile(path, []byte{}, 0o644); err != nil {
+					t.Fatalf("ファイルの書き込みに失敗: %v", err)
+				}
+			}
+
+			want := ""
+			if tt.want != "" {
+				want = filepath.Join(root, tt.want)
+			}
+
+			got := findWranglerConfigFrom(filepath.Join(root, tt.start))
+			if got != want {
+				t.Errorf("findWranglerConfigFrom() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/list"
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+)
+
+// SelectResources はユーザーに複数のリソースを選択させる
+//
+// スペースで選択を切り替え、エンターで確定する。何も選択せずに確定した場合はカーソル位置のリソースを返す
+func SelectResources(resources []cloudflare.Resource) ([]cloudflare.Resource, error) {
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("no resources found")
+	}
+
+	if len(resources) == 1 {
+		return resources, nil
+	}
+
+	items := make([]string, len(resources))
+	for i, resource := range resources {
+		items[i] = resource.Display()
+	}
+
+	l, err := list.New(items, selectorSize)
+	if err != nil {
+		return nil, err
+	}
+
+	rl, sb, err := newPrompt()
+	if err != nil {
+		return nil, err
+	}
+
+	sel := newSelection(len(resources))
+
+	rl.Config.SetListener(func(line []rune, pos int, key rune) ([]rune, int, bool) {
+		switch key {
+		case readline.CharNext, 'j':
+			l.Next()
+		case readline.CharPrev, 'k':
+			l.Prev()
+		case readline.CharForward, 'l':
+			l.PageDown()
+		case readline.CharBackward, 'h':
+			l.PageUp()
+		case ' ':
+			sel.toggle(l.Index())
+		}
+
+		_, _ = sb.WriteString(faintStyle("space to toggle, enter to confirm"))
+		_, _ = fmt.Fprintf(sb, "%s %s", promptui.IconInitial, boldStyle("Select resources to open:"))
+
+		visible, idx := l.Items()
+		for i, item := range visible {
+			mark := "[ ]"
+			if sel.isChecked(l.Start() + i) {
+				mark = checkedStyle("[x]")
+			}
+
+			pointer := " "
+			if i == idx {
+				pointer = boldStyle("▸")
+			}
+
+			_, _ = fmt.Fprintf(sb, "%s %s %s", pointer, mark, item)
+		}
+
+		_ = sb.Flush()
+		return nil, 0, true
+	})
+
+	_, err = rl.Readline()
+
+	closePrompt(rl, sb, "")
+
+	if err != nil {
+		return nil, promptError(err)
+	}
+
+	indexes := sel.indexes(l.Index())
+	selected := make([]cloudflare.Resource, len(indexes))
+	for i, index := range indexes {
+		selected[i] = resources[index]
+	}
+
+	return selected, nil
+}
+
+// selection は複数選択の各項目が選択されているかを保持する
+type selection struct {
+	checked []bool
+}
+
+func newSelection(n int) *selection {
+	return &selection{checked: make([]bool, n)}
+}
+
+// toggle は i 番目の項目の選択を切り替える
+func (s *selection) toggle(i int) {
+	if i < 0 || i >= len(s.checked) {
+		return
+	}
+	s.checked[i] = !s.checked[i]
+}
+
+func (s *selection) isChecked(i int) bool {
+	return i >= 0 && i < len(s.checked) && s.checked[i]
+}
+
+// indexes は選択された項目のインデックスを返す。何も選択されていない場合はカーソル位置の項目を返す
+func (s *selection) indexes(cursor int) []int {
+	var indexes []int
+	for i, checked := range s.checked {
+		if checked {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(indexes) == 0 {
+		indexes = append(indexes, cursor)
+	}
+	return indexes
+}
@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSelection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		toggles []int
+		cursor  int
+		want    []int
+	}{
+		{
+			name:   "何も選択しない場合はカーソル位置の項目を返す",
+			cursor: 2,
+			want:   []int{2},
+		},
+		{
+			name:    "選択した項目を一覧の順に返す",
+			toggles: []int{3, 1},
+			cursor:  0,
+			want:    []int{1, 3},
+		},
+		{
+			name:    "2 回切り替えた項目は選択されない",
+			toggles: []int{1, 2, 1},
+			cursor:  0,
+			want:    []int{2},
+		},
+		{
+			name:    "すべての選択を外した場合はカーソル位置の項目を返す",
+			toggles: []int{1, 1},
+			cursor:  3,
+			want:    []int{3},
+		},
+		{
+			name:    "範囲外のインデックスは無視する",
+			toggles: []int{-1, 4},
+			cursor:  0,
+			want:    []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sel := newSelection(4)
+			for _, i := range tt.toggles {
+				sel.toggle(i)
+			}
+			if got := sel.indexes(tt.cursor); !slices.Equal(got, tt.want) {
+				t.Errorf("indexes(%d) = %v, want %v", tt.cursor, got, tt.want)
+			}
+		})
+	}
+}
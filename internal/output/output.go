@@ -0,0 +1,229 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+)
+
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatYAML     Format = "yaml"
+	FormatCSV      Format = "csv"
+	FormatMarkdown Format = "markdown"
+	FormatTemplate Format = "template"
+)
+
+// AllFormats は `--format` に指定できるすべての形式
+var AllFormats = []Format{FormatJSON, FormatYAML, FormatCSV, FormatMarkdown, FormatTemplate}
+
+// ParseFormat は文字列を Format に変換する
+func ParseFormat(s string) (Format, error) {
+	for _, f := range AllFormats {
+		if strings.EqualFold(string(f), s) {
+			return f, nil
+		}
+	}
+
+	names := make([]string, len(AllFormats))
+	for i, f := range AllFormats {
+		names[i] = string(f)
+	}
+	return "", fmt.Errorf("unknown format %q (available: %s)", s, strings.Join(names, ", "))
+}
+
+// Record は出力するリソースの情報
+//
+// スクリプトから参照されるため、フィールド名は変更しない
+type Record struct {
+	Type        string `json:"type"`
+	Binding     string `json:"binding"`
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	Project     string `json:"project,omitempty"`
+}
+
+// NewRecords はリソースを出力用のレコードに変換する
+func NewRecords(resources []cloudflare.Resource) []Record {
+	records := make([]Record, len(resources))
+	for i, r := range resources {
+		records[i] = Record{
+			Type:        string(r.Type),
+			Binding:     r.Name,
+			ID:          r.ID,
+			Description: r.Description,
+			URL:         r.URL,
+			Project:     r.Project,
+		}
+	}
+	return records
+}
+
+// Write はリソースを指定した形式で w に書き出す
+//
+// tmpl は FormatTemplate の場合のみ使い、リソースごとに Record を渡して実行する
+func Write(w io.Writer, resources []cloudflare.Resource, format Format, tmpl string) error {
+	records := NewRecords(resources)
+
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, records)
+	case FormatYAML:
+		return writeYAML(w, records)
+	case FormatCSV:
+		return writeCSV(w, records)
+	case FormatMarkdown:
+		return writeMarkdown(w, records)
+	case FormatTemplate:
+		return writeTemplate(w, records, tmpl)
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func writeJSON(w io.Writer, records []Record) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(records)
+}
+
+// writeYAML は YAML のシーケンスとして書き出す。文字列はすべてダブルクォートで囲む
+func writeYAML(w io.Writer, records []Record) error {
+	if len(records) == 0 {
+		_, err := fmt.Fprintln(w, "[]")
+		return err
+	}
+
+	var b strings.Builder
+	for _, r := range records {
+		for i, field := range r.fields() {
+			prefix := "  "
+			if i == 0 {
+				prefix = "- "
+			}
+			fmt.Fprintf(&b, "%s%s: %s\n", prefix, field.name, strconv.Quote(field.value))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeCSV(w io.Writer, records []Record) error {
+	withProject := hasProject(records)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header(withProject)); err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err := writer.Write(r.values(withProject)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeMarkdown(w io.Writer, records []Record) error {
+	withProject := hasProject(records)
+	columns := header(withProject)
+
+	var b strings.Builder
+	writeMarkdownRow(&b, columns)
+
+	separators := make([]string, len(columns))
+	for i := range separators {
+		separators[i] = "---"
+	}
+	writeMarkdownRow(&b, separators)
+
+	for _, r := range records {
+		writeMarkdownRow(&b, r.values(withProject))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.ReplaceAll(cell, "|", `\|`)
+	}
+	fmt.Fprintf(b, "| %s |\n", strings.Join(escaped, " | "))
+}
+
+func writeTemplate(w io.Writer, records []Record, tmpl string) error {
+	if tmpl == "" {
+		return fmt.Errorf("--template is required for the template format")
+	}
+
+	t, err := template.New("output").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	for _, r := range records {
+		if err := t.Execute(w, r); err != nil {
+			return fmt.Errorf("failed to execute template: %w", err)
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type field struct {
+	name  string
+	value string
+}
+
+// fields は出力するフィールドを順に返す。プロジェクトは空でない場合のみ含める
+func (r Record) fields() []field {
+	fields := []field{
+		{name: "type", value: r.Type},
+		{name: "binding", value: r.Binding},
+		{name: "id", value: r.ID},
+		{name: "description", value: r.Description},
+		{name: "url", value: r.URL},
+	}
+	if r.Project != "" {
+		fields = append(fields, field{name: "project", value: r.Project})
+	}
+	return fields
+}
+
+func (r Record) values(withProject bool) []string {
+	values := []string{r.Type, r.Binding, r.ID, r.Description, r.URL}
+	if withProject {
+		values = append(values, r.Project)
+	}
+	return values
+}
+
+func header(withProject bool) []string {
+	columns := []string{"type", "binding", "id", "description", "url"}
+	if withProject {
+		columns = append(columns, "project")
+	}
+	return columns
+}
+
+// hasProject はワークスペースモードでプロジェクトを持つレコードがあるかを返す
+func hasProject(records []Record) bool {
+	return slices.ContainsFunc(records, func(r Record) bool {
+		return r.Project != ""
+	})
+}
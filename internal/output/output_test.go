@@ -0,0 +1,194 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mst-mkt/cf-open/internal/cloudflare"
+)
+
+var testResources = []cloudflare.Resource{
+	{
+		Type:        cloudflare.ResourceTypeWorker,
+		Name:        "my-worker",
+		ID:          "my-worker",
+		Description: "Worker: my-worker",
+		URL:         "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production",
+	},
+	{
+		Type:        cloudflare.ResourceTypeD1,
+		Name:        "DB",
+		ID:          "d1-id",
+		Description: `D1: "main" | db (d1-id)`,
+		URL:         "https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics",
+	},
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resources []cloudflare.Resource
+		format    Format
+		tmpl      string
+		want      string
+	}{
+		{
+			name:      "JSON",
+			resources: testResources,
+			format:    FormatJSON,
+			want: `[
+  {
+    "type": "worker",
+    "binding": "my-worker",
+    "id": "my-worker",
+    "description": "Worker: my-worker",
+    "url": "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production"
+  },
+  {
+    "type": "d1",
+    "binding": "DB",
+    "id": "d1-id",
+    "description": "D1: \"main\" | db (d1-id)",
+    "url": "https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics"
+  }
+]
+`,
+		},
+		{
+			name:      "空の JSON",
+			resources: nil,
+			format:    FormatJSON,
+			want:      "[]\n",
+		},
+		{
+			name:      "YAML",
+			resources: testResources,
+			format:    FormatYAML,
+			want: `- type: "worker"
+  binding: "my-worker"
+  id: "my-worker"
+  description: "Worker: my-worker"
+  url: "https://dash.cloudflare.com/acc/workers/services/view/my-worker/production"
+- type: "d1"
+  binding: "DB"
+  id: "d1-id"
+  description: "D1: \"main\" | db (d1-id)"
+  url: "https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics"
+`,
+		},
+		{
+			name:      "空の YAML",
+			resources: nil,
+			format:    FormatYAML,
+			want:      "[]\n",
+		},
+		{
+			name:      "CSV",
+			resources: testResources,
+			format:    FormatCSV,
+			want: `type,binding,id,description,url
+worker,my-worker,my-worker,Worker: my-worker,https://dash.cloudflare.com/acc/workers/services/view/my-worker/production
+d1,DB,d1-id,"D1: ""main"" | db (d1-id)",https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics
+`,
+		},
+		{
+			name:      "Markdown",
+			resources: testResources,
+			format:    FormatMarkdown,
+			want: `| type | binding | id | description | url |
+| --- | --- | --- | --- | --- |
+| worker | my-worker | my-worker | Worker: my-worker | https://dash.cloudflare.com/acc/workers/services/view/my-worker/production |
+| d1 | DB | d1-id | D1: "main" \| db (d1-id) | https://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics |
+`,
+		},
+		{
+			name:      "テンプレート",
+			resources: testResources,
+			format:    FormatTemplate,
+			tmpl:      "{{.Type}}\t{{.Binding}}\t{{.URL}}",
+			want: "worker\tmy-worker\thttps://dash.cloudflare.com/acc/workers/services/view/my-worker/production\n" +
+				"d1\tDB\thttps://dash.cloudflare.com/acc/workers/d1/databases/d1-id/metrics\n",
+		},
+		{
+			name: "プロジェクトがある場合はプロジェクトの列を加える",
+			resources: []cloudflare.Resource{
+				{Type: cloudflare.ResourceTypeKV, Name: "KV", ID: "kv-id", Description: "KV: KV (kv-id)", URL: "https://example.com/kv", Project: "apps/api"},
+				{Type: cloudflare.ResourceTypeKV, Name: "KV", ID: "kv-id-2", Description: "KV: KV (kv-id-2)", URL: "https://example.com/kv2"},
+			},
+			format: FormatCSV,
+			want: `type,binding,id,description,url,project
+kv,KV,kv-id,KV: KV (kv-id),https://example.com/kv,apps/api
+kv,KV,kv-id-2,KV: KV (kv-id-2),https://example.com/kv2,
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := Write(&buf, tt.resources, tt.format, tt.tmpl); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Write() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrite_TemplateError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tmpl string
+	}{
+		{name: "テンプレートが空", tmpl: ""},
+		{name: "構文エラー", tmpl: "{{.Type"},
+		{name: "存在しないフィールド", tmpl: "{{.Unknown}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := Write(&buf, testResources, FormatTemplate, tt.tmpl); err == nil {
+				t.Error("Write() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    Format
+		wantErr bool
+	}{
+		{input: "json", want: FormatJSON},
+		{input: "YAML", want: FormatYAML},
+		{input: "markdown", want: FormatMarkdown},
+		{input: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseFormat(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/chzyer/readline"
+	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/screenbuf"
+)
+
+const (
+	hideCursor = "\033[?25l"
+	showCursor = "\033[?25h"
+)
+
+var (
+	faintStyle   = promptui.Styler(promptui.FGFaint)
+	boldStyle    = promptui.Styler(promptui.FGBold)
+	checkedStyle = promptui.Styler(promptui.FGGreen)
+)
+
+// newPrompt は選択画面を描画するための readline と画面バッファを用意する
+//
+// キー入力は呼び出し側が rl.Config.SetListener で処理し、終了時は closePrompt を呼ぶ
+func newPrompt() (*readline.Instance, *screenbuf.ScreenBuf, error) {
+	c := &readline.Config{}
+	if err := c.Init(); err != nil {
+		return nil, nil, err
+	}
+	c.Stdin = readline.NewCancelableStdin(c.Stdin)
+	// `--format` の出力をリダイレクトしても選択画面が混ざらないように、標準エラー出力に描画する
+	c.Stdout = os.Stderr
+	c.Stderr = os.Stderr
+	c.HistoryLimit = -1
+	c.UniqueEditLine = true
+
+	rl, err := readline.NewEx(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, _ = rl.Write([]byte(hideCursor))
+	return rl, screenbuf.New(rl), nil
+}
+
+// closePrompt は選択画面を消して summary を表示し、readline を閉じる
+func closePrompt(rl *readline.Instance, sb *screenbuf.ScreenBuf, summary string) {
+	sb.Reset()
+	_, _ = sb.WriteString(summary)
+	_ = sb.Flush()
+	_, _ = rl.Write([]byte(showCursor))
+	_ = rl.Close()
+}
+
+// promptError は中断やキャンセルによる readline のエラーを選択のキャンセルとして返す
+func promptError(err error) error {
+	if errors.Is(err, readline.ErrInterrupt) || errors.Is(err, io.EOF) {
+		return fmt.Errorf("selection cancelled: %w", promptui.ErrInterrupt)
+	}
+	return err
+}
@@ -2,11 +2,22 @@ package internal
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/chzyer/readline"
 	"github.com/manifoldco/promptui"
+	"github.com/manifoldco/promptui/list"
 	"github.com/mst-mkt/cf-open/internal/cloudflare"
 )
 
+// selectorSize は選択肢を一度に表示する数
+const selectorSize = 10
+
+var highlightStyle = promptui.Styler(promptui.FGCyan, promptui.FGBold)
+
+// SelectResource はユーザーにリソースを 1 つ選択させる
+//
+// 入力した文字列でリソースを絞り込み、一致した文字を強調表示する
 func SelectResource(resources []cloudflare.Resource) (*cloudflare.Resource, error) {
 	if len(resources) == 0 {
 		return nil, fmt.Errorf("no resources found")
@@ -21,16 +32,123 @@ func SelectResource(resources []cloudflare.Resource) (*cloudflare.Resource, erro
 		items[i] = resource.Display()
 	}
 
-	prompt := promptui.Select{
-		Label:    "Select a resource to open",
-		Items:    items,
-		HideHelp: true,
+	l, err := list.New(items, selectorSize)
+	if err != nil {
+		return nil, err
+	}
+	l.Searcher = func(input string, index int) bool {
+		return resources[index].MatchesFuzzy(input)
+	}
+
+	rl, sb, err := newPrompt()
+	if err != nil {
+		return nil, err
+	}
+
+	// 検索語は入力を直接追跡し、空になった場合も強調表示に反映する
+	cur := promptui.NewCursor("", promptui.DefaultCursor, false)
+
+	rl.Config.SetListener(func(line []rune, pos int, key rune) ([]rune, int, bool) {
+		switch key {
+		case readline.CharEnter:
+			return nil, 0, true
+		case readline.CharNext:
+			l.Next()
+		case readline.CharPrev:
+			l.Prev()
+		case readline.CharForward:
+			l.PageDown()
+		case readline.CharBackward:
+			l.PageUp()
+		case promptui.KeyBackspace, promptui.KeyCtrlH:
+			cur.Backspace()
+			search(l, cur.Get())
+		default:
+			cur.Update(string(line))
+			search(l, cur.Get())
+		}
+
+		query := cur.Get()
+		_, _ = sb.WriteString(promptui.SearchPrompt + cur.Format())
+		_, _ = fmt.Fprintf(sb, "%s %s", promptui.IconInitial, boldStyle("Select a resource to open:"))
+
+		visible, idx := l.Items()
+		for i, item := range visible {
+			page := " "
+			switch {
+			case i == 0 && l.CanPageUp():
+				page = "↑"
+			case i == len(visible)-1 && l.CanPageDown():
+				page = "↓"
+			}
+
+			pointer := " "
+			if i == idx {
+				pointer = boldStyle("▸")
+			}
+
+			_, _ = fmt.Fprintf(sb, "%s %s %s", page, pointer, highlightMatches(fmt.Sprint(item), query))
+		}
+		if idx == list.NotFound {
+			_, _ = sb.WriteString("")
+			_, _ = sb.WriteString("No results")
+		}
+
+		_ = sb.Flush()
+		return nil, 0, true
+	})
+
+	// 一致するリソースがない状態で確定した場合は入力を続ける
+	for {
+		_, err = rl.Readline()
+		if err != nil {
+			break
+		}
+		if _, idx := l.Items(); idx != list.NotFound {
+			break
+		}
+	}
+
+	var summary string
+	if err == nil {
+		summary = fmt.Sprintf("%s %s", checkedStyle(promptui.IconGood), faintStyle(items[l.Index()]))
 	}
+	closePrompt(rl, sb, summary)
 
-	index, _, err := prompt.Run()
 	if err != nil {
-		return nil, fmt.Errorf("selection cancelled: %w", err)
+		return nil, promptError(err)
 	}
 
-	return &resources[index], nil
+	return &resources[l.Index()], nil
+}
+
+// search は検索語で一覧を絞り込む。検索語が空の場合はすべてのリソースを表示する
+func search(l *list.List, query string) {
+	if strings.TrimSpace(query) == "" {
+		l.CancelSearch()
+		return
+	}
+	l.Search(query)
+}
+
+// highlightMatches は text のうち query の各語にあいまい一致した文字を強調する
+func highlightMatches(text, query string) string {
+	runes := []rune(text)
+	highlighted := make([]bool, len(runes))
+	for term := range strings.FieldsSeq(query) {
+		positions, _ := cloudflare.FuzzyMatch(text, term)
+		for _, i := range positions {
+			highlighted[i] = true
+		}
+	}
+
+	var b strings.Builder
+	for i, r := range runes {
+		if highlighted[i] {
+			b.WriteString(highlightStyle(string(r)))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
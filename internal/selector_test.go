@@ -0,0 +1,63 @@
+package internal
+
+import "testing"
+
+func TestHighlightMatches(t *testing.T) {
+	t.Parallel()
+
+	h := highlightStyle
+
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{
+			name:  "検索語が空の場合は強調しない",
+			text:  "kv: CACHE",
+			query: "",
+			want:  "kv: CACHE",
+		},
+		{
+			name:  "空白のみの検索語は強調しない",
+			text:  "kv: CACHE",
+			query: "  ",
+			want:  "kv: CACHE",
+		},
+		{
+			name:  "あいまい一致した文字を強調する",
+			text:  "kv: CACHE",
+			query: "kch",
+			want:  h("k") + "v: " + h("C") + "AC" + h("H") + "E",
+		},
+		{
+			name:  "複数の語はそれぞれ強調する",
+			text:  "d1: DB",
+			query: "1 b",
+			want:  "d" + h("1") + ": D" + h("B"),
+		},
+		{
+			name:  "一致しない語は無視する",
+			text:  "r2: assets",
+			query: "zzz",
+			want:  "r2: assets",
+		},
+		{
+			name:  "マルチバイト文字を含む",
+			text:  "Worker: 日本語",
+			query: "本",
+			want:  "Worker: 日" + h("本") + "語",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := highlightMatches(tt.text, tt.query); got != tt.want {
+				t.Errorf("highlightMatches(%q, %q) = %q, want %q", tt.text, tt.query, got, tt.want)
+			}
+		})
+	}
+}